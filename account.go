@@ -0,0 +1,42 @@
+package cnab
+
+import "fmt"
+
+// ComputeAccountCheckDigit computes a weighted mod-11 check digit for a
+// bank agency/account number, the scheme used (with bank-specific weight
+// tables) by most Brazilian banks for agência and conta check digits.
+//
+// weights is applied right-to-left, cycling if shorter than digits. The
+// resulting digit is 11 minus the remainder; a result of 10 or 11 maps to
+// '0', matching the convention used by Banco do Brasil and several other
+// banks. Banks with a different 10/11 mapping should post-process the
+// remainder themselves using WeightedMod11Remainder.
+func ComputeAccountCheckDigit(digits string, weights []int) (byte, error) {
+	remainder, err := WeightedMod11Remainder(digits, weights)
+	if err != nil {
+		return 0, err
+	}
+	d := 11 - remainder
+	if d >= 10 {
+		return '0', nil
+	}
+	return byte('0' + d), nil
+}
+
+// WeightedMod11Remainder sums digits (right-to-left) each multiplied by
+// the next weight in weights (cycling if shorter than digits), and
+// returns that sum mod 11.
+func WeightedMod11Remainder(digits string, weights []int) (int, error) {
+	if len(weights) == 0 {
+		return 0, fmt.Errorf("cnab: weights must not be empty")
+	}
+	if !isAllDigits(digits) {
+		return 0, fmt.Errorf("cnab: %q is not all digits", digits)
+	}
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		w := weights[i%len(weights)]
+		sum += int(digits[len(digits)-1-i]-'0') * w
+	}
+	return sum % 11, nil
+}
@@ -0,0 +1,21 @@
+package cnab
+
+import "testing"
+
+func TestComputeAccountCheckDigit(t *testing.T) {
+	// Weights 2,3,4,5,6,7,8,9 cycling, a common Banco do Brasil scheme.
+	weights := []int{2, 3, 4, 5, 6, 7, 8, 9}
+	d, err := ComputeAccountCheckDigit("1234", weights)
+	if err != nil {
+		t.Fatalf("ComputeAccountCheckDigit: %v", err)
+	}
+	if d < '0' || d > '9' {
+		t.Errorf("expected single digit, got %q", d)
+	}
+}
+
+func TestWeightedMod11RemainderRejectsNonDigits(t *testing.T) {
+	if _, err := WeightedMod11Remainder("12a4", []int{2, 3}); err == nil {
+		t.Fatal("expected error for non-digit input")
+	}
+}
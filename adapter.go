@@ -0,0 +1,26 @@
+package cnab
+
+// LegacyFieldSpec describes a field using the 1-based inclusive
+// Start/End convention found in several other Go CNAB libraries, making
+// it possible to adopt cnab-stream without rewriting existing specs by
+// hand.
+type LegacyFieldSpec struct {
+	Name  string
+	Start int
+	End   int
+}
+
+// AdaptLegacySpec converts fields expressed with 1-based inclusive
+// Start/End offsets into a RecordSpec using this package's zero-based
+// Start/Length convention.
+func AdaptLegacySpec(recordType string, fields []LegacyFieldSpec) RecordSpec {
+	spec := RecordSpec{Type: recordType, Fields: make([]FieldSpec, len(fields))}
+	for i, f := range fields {
+		spec.Fields[i] = FieldSpec{
+			Name:   f.Name,
+			Start:  f.Start - 1,
+			Length: f.End - f.Start + 1,
+		}
+	}
+	return spec
+}
@@ -0,0 +1,20 @@
+package cnab
+
+import "testing"
+
+func TestAdaptLegacySpec(t *testing.T) {
+	spec := AdaptLegacySpec("detail", []LegacyFieldSpec{
+		{Name: "agency", Start: 1, End: 4},
+		{Name: "account", Start: 5, End: 10},
+	})
+	rec, err := ParseRecord(spec, "0001123456")
+	if err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+	if v, _ := rec.Get("agency"); v != "0001" {
+		t.Errorf("agency = %q, want 0001", v)
+	}
+	if v, _ := rec.Get("account"); v != "123456" {
+		t.Errorf("account = %q, want 123456", v)
+	}
+}
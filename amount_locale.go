@@ -0,0 +1,121 @@
+package cnab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// amountLocaleSeparators returns the thousands and decimal separator
+// characters used to write an amount in locale, e.g. "eu" writes
+// "1.234,56" (period thousands, comma decimal) and "us" writes
+// "1,234.56" (comma thousands, period decimal).
+func amountLocaleSeparators(locale string) (thousands, decimal byte, err error) {
+	switch locale {
+	case "eu":
+		return '.', ',', nil
+	case "us":
+		return ',', '.', nil
+	default:
+		return 0, 0, fmt.Errorf("cnab: unknown amount locale %q", locale)
+	}
+}
+
+// delocalizeAmount strips locale's thousands separator and folds its
+// decimal separator into the digit run, turning a human-formatted amount
+// like "1.234,56" into the plain implied-decimal digit string ("123456")
+// this package's fields otherwise store, per FieldSpec.Decimals.
+func delocalizeAmount(value, locale string) (string, error) {
+	thousands, decimal, err := amountLocaleSeparators(locale)
+	if err != nil {
+		return "", err
+	}
+	v := strings.TrimSpace(value)
+	sign := ""
+	if strings.HasPrefix(v, "-") || strings.HasPrefix(v, "+") {
+		sign, v = v[:1], v[1:]
+	}
+	v = strings.ReplaceAll(v, string(thousands), "")
+	v = strings.ReplaceAll(v, string(decimal), "")
+	for i := 0; i < len(v); i++ {
+		if v[i] < '0' || v[i] > '9' {
+			return "", fmt.Errorf("cnab: value %q is not a valid %s-locale amount", value, locale)
+		}
+	}
+	return sign + v, nil
+}
+
+// localizeAmount is the inverse of delocalizeAmount: it inserts locale's
+// decimal separator decimals digits from the end and its thousands
+// separator every three digits before that.
+func localizeAmount(value, locale string, decimals int) (string, error) {
+	thousands, decimal, err := amountLocaleSeparators(locale)
+	if err != nil {
+		return "", err
+	}
+	v := strings.TrimSpace(value)
+	sign := ""
+	if strings.HasPrefix(v, "-") || strings.HasPrefix(v, "+") {
+		sign, v = v[:1], v[1:]
+	}
+	for i := 0; i < len(v); i++ {
+		if v[i] < '0' || v[i] > '9' {
+			return "", fmt.Errorf("cnab: value %q is not numeric", value)
+		}
+	}
+	if decimals > 0 {
+		for len(v) <= decimals {
+			v = "0" + v
+		}
+	}
+	intPart, decPart := v, ""
+	if decimals > 0 {
+		intPart, decPart = v[:len(v)-decimals], v[len(v)-decimals:]
+	}
+
+	var grouped strings.Builder
+	for i, c := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			grouped.WriteByte(thousands)
+		}
+		grouped.WriteRune(c)
+	}
+
+	out := sign + grouped.String()
+	if decimals > 0 {
+		out += string(decimal) + decPart
+	}
+	return out, nil
+}
+
+func init() {
+	// delocalize normalizes a locale-formatted amount ("1.234,56" for
+	// "delocalize:eu") into this package's plain implied-decimal digit
+	// convention, for use as a PreParse transform on bespoke layouts
+	// that embed thousands/decimal separators instead of a fixed-width
+	// unscaled integer.
+	RegisterTransform("delocalize", func(arg string) (Transform, error) {
+		locale := strings.TrimSpace(arg)
+		if _, _, err := amountLocaleSeparators(locale); err != nil {
+			return nil, err
+		}
+		return func(v string) (string, error) { return delocalizeAmount(v, locale) }, nil
+	})
+	// localize is the PostFormat inverse of delocalize, taking
+	// "locale:decimals" (e.g. "localize:eu:2") so it knows where to place
+	// the decimal separator in the plain digit string.
+	RegisterTransform("localize", func(arg string) (Transform, error) {
+		locale, decStr, ok := strings.Cut(arg, ":")
+		if !ok {
+			return nil, fmt.Errorf("cnab: localize: expected \"locale:decimals\", got %q", arg)
+		}
+		decimals, err := strconv.Atoi(strings.TrimSpace(decStr))
+		if err != nil {
+			return nil, fmt.Errorf("cnab: localize: invalid decimals %q: %w", decStr, err)
+		}
+		if _, _, err := amountLocaleSeparators(locale); err != nil {
+			return nil, err
+		}
+		return func(v string) (string, error) { return localizeAmount(v, locale, decimals) }, nil
+	})
+}
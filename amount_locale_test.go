@@ -0,0 +1,53 @@
+package cnab
+
+import "testing"
+
+func TestDelocalizeAmountEuropeanFormat(t *testing.T) {
+	got, err := delocalizeAmount("1.234,56", "eu")
+	if err != nil {
+		t.Fatalf("delocalizeAmount: %v", err)
+	}
+	if got != "123456" {
+		t.Errorf("got %q, want 123456", got)
+	}
+}
+
+func TestLocalizeAmountEuropeanFormat(t *testing.T) {
+	got, err := localizeAmount("123456", "eu", 2)
+	if err != nil {
+		t.Fatalf("localizeAmount: %v", err)
+	}
+	if got != "1.234,56" {
+		t.Errorf("got %q, want 1.234,56", got)
+	}
+}
+
+func TestAmountLocaleRoundTripThroughFieldTransforms(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "amount", Start: 0, Length: 8, PreParse: []string{"delocalize:eu"}},
+	}}
+	rec, err := ParseRecord(spec, "1.234,56")
+	if err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+	if v, _ := rec.Get("amount"); v != "123456" {
+		t.Errorf("parsed amount = %q, want 123456", v)
+	}
+
+	packSpec := RecordSpec{Fields: []FieldSpec{
+		{Name: "amount", Start: 0, Length: 8, PostFormat: []string{"localize:eu:2"}},
+	}}
+	line, err := PackRecord(packSpec, map[string]string{"amount": "123456"})
+	if err != nil {
+		t.Fatalf("PackRecord: %v", err)
+	}
+	if line != "1.234,56" {
+		t.Errorf("packed line = %q, want 1.234,56", line)
+	}
+}
+
+func TestDelocalizeAmountRejectsGarbage(t *testing.T) {
+	if _, err := delocalizeAmount("12x34", "eu"); err == nil {
+		t.Error("expected an error for a non-numeric amount")
+	}
+}
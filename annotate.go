@@ -0,0 +1,39 @@
+package cnab
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Annotate renders a field-by-field breakdown of record against spec, one
+// line per field showing its byte range, ASCII value, and hex encoding,
+// for pasting into a support ticket or terminal when eyeballing a
+// mis-parsed line. It does not require record to parse successfully:
+// fields beyond the end of record are marked "<out of range>" instead of
+// erroring, since a truncated line is exactly the kind of thing an
+// operator reaches for Annotate to diagnose.
+func Annotate(spec RecordSpec, record string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-24s %6s %6s  %-30s %s\n", "FIELD", "START", "END", "VALUE", "HEX")
+	for _, f := range spec.Fields {
+		if f.End() > len(record) {
+			fmt.Fprintf(&b, "%-24s %6d %6d  %-30s %s\n", f.Name, f.Start, f.End(), "<out of range>", "-")
+			continue
+		}
+		value := record[f.Start:f.End()]
+		fmt.Fprintf(&b, "%-24s %6d %6d  %-30q %s\n", f.Name, f.Start, f.End(), value, hexBytes(value))
+	}
+	return b.String()
+}
+
+// hexBytes renders s as space-separated hex byte pairs.
+func hexBytes(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "%02x", s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,28 @@
+package cnab
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAnnotateShowsFieldRangesAndHex(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "id", Start: 0, Length: 2},
+		{Name: "name", Start: 2, Length: 3},
+	}}
+	out := Annotate(spec, "01BOB")
+	if !strings.Contains(out, "id") || !strings.Contains(out, "01") {
+		t.Errorf("output missing id field: %s", out)
+	}
+	if !strings.Contains(out, "42 4f 42") { // "BOB" in hex
+		t.Errorf("output missing hex for name field: %s", out)
+	}
+}
+
+func TestAnnotateMarksFieldsOutOfRange(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "id", Start: 0, Length: 10}}}
+	out := Annotate(spec, "01")
+	if !strings.Contains(out, "<out of range>") {
+		t.Errorf("expected out-of-range marker, got: %s", out)
+	}
+}
@@ -0,0 +1,45 @@
+package cnab
+
+// AppendRecord packs values according to spec and appends the resulting
+// bytes to buf, returning the extended buffer. It lets callers building a
+// whole file reuse one growing buffer instead of allocating a string per
+// record via PackRecord.
+func AppendRecord(buf []byte, spec RecordSpec, values map[string]string) ([]byte, error) {
+	width := 0
+	for _, f := range spec.Fields {
+		if f.End() > width {
+			width = f.End()
+		}
+	}
+
+	start := len(buf)
+	buf = append(buf, make([]byte, width)...)
+	for i := start; i < len(buf); i++ {
+		buf[i] = ' '
+	}
+
+	for _, f := range spec.Fields {
+		v, ok := values[f.Name]
+		if !ok {
+			v = f.Default
+		}
+		if len(f.PostFormat) > 0 {
+			transformed, err := applyTransforms(f.PostFormat, v)
+			if err != nil {
+				return buf[:start], err
+			}
+			v = transformed
+		}
+		if err := validateField(f, v); err != nil {
+			return buf[:start], err
+		}
+		if len(v) > f.Length {
+			v = v[:f.Length]
+		}
+		copy(buf[start+f.Start:start+f.End()], v)
+		for i := start + f.Start + len(v); i < start+f.End(); i++ {
+			buf[i] = ' '
+		}
+	}
+	return buf, nil
+}
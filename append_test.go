@@ -0,0 +1,37 @@
+package cnab
+
+import "testing"
+
+func TestAppendRecord(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "agency", Start: 0, Length: 4},
+		{Name: "name", Start: 4, Length: 5},
+	}}
+
+	var buf []byte
+	buf, err := AppendRecord(buf, spec, map[string]string{"agency": "0001", "name": "BOB"})
+	if err != nil {
+		t.Fatalf("AppendRecord: %v", err)
+	}
+	buf, err = AppendRecord(buf, spec, map[string]string{"agency": "0002", "name": "ALICE"})
+	if err != nil {
+		t.Fatalf("AppendRecord: %v", err)
+	}
+
+	want := "0001BOB  0002ALICE"
+	if string(buf) != want {
+		t.Errorf("got %q, want %q", buf, want)
+	}
+}
+
+func TestAppendRecordError(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "kind", Start: 0, Length: 2, Validators: []string{"in:01,02"}}}}
+	buf := []byte("prefix")
+	out, err := AppendRecord(buf, spec, map[string]string{"kind": "99"})
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	if string(out) != "prefix" {
+		t.Errorf("buffer should be unchanged on error, got %q", out)
+	}
+}
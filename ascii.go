@@ -0,0 +1,28 @@
+package cnab
+
+import "fmt"
+
+// VerifyASCII checks that every byte of s is 7-bit ASCII (0x00-0x7F), as
+// required by most CNAB bank specifications. It returns an error naming
+// the byte offset and value of the first violation, or nil if s is clean.
+func VerifyASCII(s string) error {
+	for i := 0; i < len(s); i++ {
+		if s[i] > 0x7F {
+			return fmt.Errorf("cnab: non-ASCII byte 0x%02X at offset %d", s[i], i)
+		}
+	}
+	return nil
+}
+
+// VerifyASCIILines runs VerifyASCII over every line, returning a map of
+// 1-based line number to the violation found on that line. An empty map
+// means every line is clean.
+func VerifyASCIILines(lines []string) map[int]error {
+	violations := make(map[int]error)
+	for i, line := range lines {
+		if err := VerifyASCII(line); err != nil {
+			violations[i+1] = err
+		}
+	}
+	return violations
+}
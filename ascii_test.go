@@ -0,0 +1,22 @@
+package cnab
+
+import "testing"
+
+func TestVerifyASCII(t *testing.T) {
+	if err := VerifyASCII("HELLO 123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := VerifyASCII("HELLÕ"); err == nil {
+		t.Fatal("expected error for non-ASCII byte")
+	}
+}
+
+func TestVerifyASCIILines(t *testing.T) {
+	violations := VerifyASCIILines([]string{"OK", "BAD Ã", "ALSO OK"})
+	if len(violations) != 1 {
+		t.Fatalf("got %d violations, want 1", len(violations))
+	}
+	if _, ok := violations[2]; !ok {
+		t.Errorf("expected violation on line 2, got %v", violations)
+	}
+}
@@ -0,0 +1,31 @@
+package cnab
+
+// RegenerateFile packs stored header, detail and trailer field values back
+// into file lines using spec. It is the inverse of parsing a file into
+// records, and is intended for backfill tools that re-emit CNAB files from
+// data previously persisted elsewhere (a database, an event log, ...).
+func RegenerateFile(spec FileSpec, header map[string]string, details []map[string]string, trailer map[string]string) ([]string, error) {
+	lines := make([]string, 0, len(details)+2)
+
+	headerLine, err := PackRecord(spec.Header, header)
+	if err != nil {
+		return nil, err
+	}
+	lines = append(lines, headerLine)
+
+	for i, d := range details {
+		line, err := PackRecord(spec.Detail, d)
+		if err != nil {
+			return nil, &ParseError{Field: spec.Detail.Type, Msg: err.Error(), Line: i + 2}
+		}
+		lines = append(lines, line)
+	}
+
+	trailerLine, err := PackRecord(spec.Trailer, trailer)
+	if err != nil {
+		return nil, err
+	}
+	lines = append(lines, trailerLine)
+
+	return lines, nil
+}
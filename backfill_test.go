@@ -0,0 +1,28 @@
+package cnab
+
+import "testing"
+
+func TestRegenerateFile(t *testing.T) {
+	spec := FileSpec{
+		Header:  RecordSpec{Type: "header", Fields: []FieldSpec{{Name: "type", Start: 0, Length: 1}}},
+		Trailer: RecordSpec{Type: "trailer", Fields: []FieldSpec{{Name: "type", Start: 0, Length: 1}}},
+		Detail:  RecordSpec{Type: "detail", Fields: []FieldSpec{{Name: "name", Start: 0, Length: 5}}},
+	}
+	lines, err := RegenerateFile(spec,
+		map[string]string{"type": "H"},
+		[]map[string]string{{"name": "ALICE"}, {"name": "BOB"}},
+		map[string]string{"type": "T"},
+	)
+	if err != nil {
+		t.Fatalf("RegenerateFile: %v", err)
+	}
+	want := []string{"H", "ALICE", "BOB  ", "T"}
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines, want %d", len(lines), len(want))
+	}
+	for i, line := range lines {
+		if line != want[i] {
+			t.Errorf("line %d = %q, want %q", i, line, want[i])
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package cnab
+
+import "testing"
+
+func TestBarcodeLinhaDigitavelRoundTrip(t *testing.T) {
+	// A syntactically valid 44-digit barcode (general DV recomputed below).
+	raw := "23793380001" + "0000" + "123" + "4500000" + "0001234560000012345000"
+	// Ensure exactly 44 digits by trimming/padding deterministically.
+	barcode := (raw + "00000000000000000000000000000000000000000000")[:44]
+	dv := mod11BarcodeDV(barcode[:4] + barcode[5:])
+	barcode = barcode[:4] + string(dv) + barcode[5:]
+
+	if !ValidateBarcode(barcode) {
+		t.Fatalf("expected valid barcode: %s", barcode)
+	}
+
+	linha, err := BarcodeToLinhaDigitavel(barcode)
+	if err != nil {
+		t.Fatalf("BarcodeToLinhaDigitavel: %v", err)
+	}
+	if len(linha) != 47 {
+		t.Fatalf("got %d digits, want 47", len(linha))
+	}
+
+	back, err := LinhaDigitavelToBarcode(linha)
+	if err != nil {
+		t.Fatalf("LinhaDigitavelToBarcode: %v", err)
+	}
+	if back != barcode {
+		t.Errorf("round trip got %q, want %q", back, barcode)
+	}
+}
+
+func TestValidateBarcodeRejectsBadInput(t *testing.T) {
+	if ValidateBarcode("123") {
+		t.Error("expected false for short input")
+	}
+	if ValidateBarcode("1234567890123456789012345678901234567890123X") {
+		t.Error("expected false for non-digit input")
+	}
+}
@@ -0,0 +1,85 @@
+package cnab
+
+import (
+	"strconv"
+	"sync"
+)
+
+// PackBatchesParallel packs each batch of detail records concurrently
+// using spec, then reassembles the resulting lines in batch order.
+//
+// If seqField is non-empty, it names a field in spec that is renumbered
+// sequentially (starting at 1) across the whole reassembled output,
+// overriding whatever value was passed in values.
+func PackBatchesParallel(spec RecordSpec, seqField string, batches [][]map[string]string) ([]string, error) {
+	packed := make([][]string, len(batches))
+	errs := make([]error, len(batches))
+
+	var wg sync.WaitGroup
+	for i, batch := range batches {
+		wg.Add(1)
+		go func(i int, batch []map[string]string) {
+			defer wg.Done()
+			lines := make([]string, len(batch))
+			for j, values := range batch {
+				line, err := PackRecord(spec, values)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				lines[j] = line
+			}
+			packed[i] = lines
+		}(i, batch)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var out []string
+	for _, lines := range packed {
+		out = append(out, lines...)
+	}
+
+	if seqField != "" {
+		field, ok := fieldByName(spec, seqField)
+		if ok {
+			for i := range out {
+				seq := strconv.Itoa(i + 1)
+				out[i] = overwriteField(out[i], field, seq)
+			}
+		}
+	}
+
+	return out, nil
+}
+
+func fieldByName(spec RecordSpec, name string) (FieldSpec, bool) {
+	for _, f := range spec.Fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return FieldSpec{}, false
+}
+
+// overwriteField replaces the bytes occupied by f within line with value,
+// left-padded with '0' to f.Length; a value longer than f.Length keeps
+// only its rightmost f.Length bytes.
+func overwriteField(line string, f FieldSpec, value string) string {
+	buf := []byte(line)
+	if len(value) > f.Length {
+		value = value[len(value)-f.Length:]
+	}
+	padded := make([]byte, f.Length)
+	for i := range padded {
+		padded[i] = '0'
+	}
+	copy(padded[f.Length-len(value):], value)
+	copy(buf[f.Start:f.End()], padded)
+	return string(buf)
+}
@@ -0,0 +1,45 @@
+package cnab
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestPackBatchesParallelOrderedReassembly(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "seq", Start: 0, Length: 3},
+		{Name: "name", Start: 3, Length: 5},
+	}}
+
+	batches := [][]map[string]string{
+		{{"name": "ALICE"}, {"name": "BOB"}},
+		{{"name": "CARL"}},
+		{{"name": "DINA"}, {"name": "EVE"}},
+	}
+
+	lines, err := PackBatchesParallel(spec, "seq", batches)
+	if err != nil {
+		t.Fatalf("PackBatchesParallel: %v", err)
+	}
+	wantNames := []string{"ALICE", "BOB", "CARL", "DINA", "EVE"}
+	if len(lines) != len(wantNames) {
+		t.Fatalf("got %d lines, want %d", len(lines), len(wantNames))
+	}
+	for i, line := range lines {
+		rec, err := ParseRecord(spec, line)
+		if err != nil {
+			t.Fatalf("line %d: %v", i, err)
+		}
+		seq, _ := rec.Get("seq")
+		name, _ := rec.Get("name")
+		name = strings.TrimSpace(name)
+		wantSeq := fmt.Sprintf("%03d", i+1)
+		if seq != wantSeq {
+			t.Errorf("line %d: seq = %q, want %q", i, seq, wantSeq)
+		}
+		if name != wantNames[i] {
+			t.Errorf("line %d: name = %q, want %q", i, name, wantNames[i])
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package cnab
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// specWithFields builds a RecordSpec of n fixed-width, 4-byte fields, for
+// benchmarking parse/pack cost as a function of field count.
+func specWithFields(n int) (RecordSpec, string) {
+	fields := make([]FieldSpec, n)
+	var line strings.Builder
+	for i := range fields {
+		fields[i] = FieldSpec{Name: "f" + strconv.Itoa(i), Start: i * 4, Length: 4}
+		line.WriteString("0001")
+	}
+	return RecordSpec{Fields: fields}, line.String()
+}
+
+func BenchmarkParseRecord(b *testing.B) {
+	for _, n := range []int{5, 20, 50} {
+		spec, line := specWithFields(n)
+		b.Run(strconv.Itoa(n)+"fields", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := ParseRecord(spec, line); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkParseRecordIntoByFieldCount(b *testing.B) {
+	for _, n := range []int{5, 20, 50} {
+		spec, line := specWithFields(n)
+		rec := NewRecord()
+		b.Run(strconv.Itoa(n)+"fields", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := ParseRecordInto(spec, line, 0, rec); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkPackRecord(b *testing.B) {
+	for _, n := range []int{5, 20, 50} {
+		spec, _ := specWithFields(n)
+		values := make(map[string]string, n)
+		for _, f := range spec.Fields {
+			values[f.Name] = "0001"
+		}
+		b.Run(strconv.Itoa(n)+"fields", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := PackRecord(spec, values); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// digitsField240 is a 20-digit numeric value as it would appear inside a
+// 240-byte CNAB240 detail record (e.g. an amount or nosso número field),
+// used to compare parseDigitsUint64 against strconv.ParseInt/ParseUint on
+// a realistic field width.
+const digitsField240 = "00000000001234567890"
+
+func BenchmarkParseDigitsUint64(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := parseDigitsUint64(digitsField240); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseUintViaStrconv(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := strconv.ParseUint(digitsField240, 10, 64); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// TestParseRecordIntoAllocations guards the pooled reuse path
+// (ParseRecordInto) against regressing back to per-call allocations: it
+// should only allocate when Record's backing slice/map genuinely needs
+// to grow, not on every call.
+func TestParseRecordIntoAllocations(t *testing.T) {
+	spec, line := specWithFields(10)
+	rec := NewRecord()
+	// Warm up so the backing storage is already sized.
+	if err := ParseRecordInto(spec, line, 0, rec); err != nil {
+		t.Fatalf("warmup ParseRecordInto: %v", err)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		if err := ParseRecordInto(spec, line, 0, rec); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs > 0 {
+		t.Errorf("ParseRecordInto allocated %.1f times per call on the reuse path, want 0", allocs)
+	}
+}
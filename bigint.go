@@ -0,0 +1,36 @@
+package cnab
+
+import (
+	"math/big"
+	"strings"
+)
+
+// fieldTypeBigInt is the FieldSpec.Type value marking a field as an
+// arbitrary-precision integer rather than a plain string.
+const fieldTypeBigInt = "bigint"
+
+// parseBigInt trims value and parses it as a base-10 integer, returning
+// ok=false if it isn't one.
+func parseBigInt(value string) (*big.Int, bool) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return nil, false
+	}
+	return new(big.Int).SetString(trimmed, 10)
+}
+
+// BigInt returns the named field's value parsed as an arbitrary-precision
+// integer, for fields declared with FieldSpec.Type "bigint" (e.g. a
+// 25-digit nosso número, too wide for a uint64 accumulator). ok is false
+// if the field is absent or its stored value is not a valid base-10
+// integer.
+func (r *Record) BigInt(name string) (n *big.Int, ok bool) {
+	if r == nil {
+		return nil, false
+	}
+	v, present := r.Get(name)
+	if !present {
+		return nil, false
+	}
+	return parseBigInt(v)
+}
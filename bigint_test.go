@@ -0,0 +1,37 @@
+package cnab
+
+import "testing"
+
+func TestBigIntFieldParsesArbitraryPrecisionValue(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "nosso_numero", Start: 0, Length: 25, Type: "bigint"},
+	}}
+	line := "1234567890123456789012345"
+	rec, err := ParseRecord(spec, line)
+	if err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+	n, ok := rec.BigInt("nosso_numero")
+	if !ok {
+		t.Fatal("BigInt: ok = false, want true")
+	}
+	if n.String() != line {
+		t.Errorf("BigInt = %s, want %s", n.String(), line)
+	}
+}
+
+func TestBigIntFieldRejectsNonNumeric(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "nosso_numero", Start: 0, Length: 10, Type: "bigint"},
+	}}
+	if _, err := ParseRecord(spec, "12345abc90"); err == nil {
+		t.Fatal("expected a bigint validation error")
+	}
+}
+
+func TestBigIntAbsentField(t *testing.T) {
+	rec := NewRecord()
+	if _, ok := rec.BigInt("missing"); ok {
+		t.Fatal("BigInt on absent field: ok = true, want false")
+	}
+}
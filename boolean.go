@@ -0,0 +1,26 @@
+package cnab
+
+import "strings"
+
+// ParseBoolField reports whether value matches one of truthy tokens
+// (case-insensitive, trimmed). CNAB layouts encode booleans with
+// inconsistent tokens across banks ("S"/"N", "1"/"0", "SIM"/"NAO"), so the
+// set of truthy tokens is caller-supplied rather than fixed.
+func ParseBoolField(value string, truthy []string) bool {
+	v := strings.ToUpper(strings.TrimSpace(value))
+	for _, t := range truthy {
+		if v == strings.ToUpper(strings.TrimSpace(t)) {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatBoolField renders b as trueToken or falseToken, for use as a
+// PackRecord value.
+func FormatBoolField(b bool, trueToken, falseToken string) string {
+	if b {
+		return trueToken
+	}
+	return falseToken
+}
@@ -0,0 +1,22 @@
+package cnab
+
+import "testing"
+
+func TestParseBoolField(t *testing.T) {
+	truthy := []string{"S", "1", "SIM"}
+	if !ParseBoolField(" s ", truthy) {
+		t.Error("expected true for 's'")
+	}
+	if ParseBoolField("N", truthy) {
+		t.Error("expected false for 'N'")
+	}
+}
+
+func TestFormatBoolField(t *testing.T) {
+	if got := FormatBoolField(true, "S", "N"); got != "S" {
+		t.Errorf("got %q, want S", got)
+	}
+	if got := FormatBoolField(false, "S", "N"); got != "N" {
+		t.Errorf("got %q, want N", got)
+	}
+}
@@ -0,0 +1,76 @@
+package cnab
+
+import "fmt"
+
+// RecordBuilder assembles a record's field values one at a time via
+// chained Set calls, validating each against its FieldSpec immediately
+// so a bad value is attributed to its field right away, instead of
+// surfacing only once at Build time after a caller has already lost
+// track of which map entry caused it.
+type RecordBuilder struct {
+	spec   RecordSpec
+	values map[string]string
+	fill   byte
+	errs   []error
+}
+
+// NewRecordBuilder returns a RecordBuilder for spec. Build fills unset
+// field bytes with a space, as PackRecord does; use WithFill to change
+// that.
+func NewRecordBuilder(spec RecordSpec) *RecordBuilder {
+	return &RecordBuilder{spec: spec, values: make(map[string]string), fill: ' '}
+}
+
+// WithFill sets the filler byte Build uses, as PackRecordFill does.
+func (b *RecordBuilder) WithFill(fill byte) *RecordBuilder {
+	b.fill = fill
+	return b
+}
+
+// Set validates value against name's FieldSpec and stores it for Build.
+// An unknown field name, or a value failing its Validators, is recorded
+// as an error rather than stopping the chain, so a caller can keep
+// calling Set and inspect every problem at once via Errors or Build.
+// Validation runs against value after f.PostFormat is applied, the same
+// value PackRecordFill will actually pack, so a validator like
+// "in:0001,0007" sees "0007" rather than the raw "7" a PostFormat rule
+// such as "zerofill:4" will go on to produce.
+func (b *RecordBuilder) Set(name, value string) *RecordBuilder {
+	f, ok := b.spec.FieldByName(name)
+	if !ok {
+		b.errs = append(b.errs, fmt.Errorf("cnab: unknown field %q", name))
+		return b
+	}
+	formatted := value
+	if len(f.PostFormat) > 0 {
+		transformed, err := applyTransforms(f.PostFormat, value)
+		if err != nil {
+			b.errs = append(b.errs, fmt.Errorf("field %q: %w", name, err))
+			return b
+		}
+		formatted = transformed
+	}
+	if err := validateField(f, formatted); err != nil {
+		b.errs = append(b.errs, fmt.Errorf("field %q: %w", name, err))
+		return b
+	}
+	// Build/PackRecordFill applies f.PostFormat itself, so the raw value
+	// (not formatted) is what gets stored here — only validation needs to
+	// see the post-formatted value, to match what will actually be packed.
+	b.values[name] = value
+	return b
+}
+
+// Errors returns every error recorded by Set so far, in call order.
+func (b *RecordBuilder) Errors() []error {
+	return b.errs
+}
+
+// Build packs the accumulated values against spec, returning the first
+// error recorded by Set (if any) instead of attempting to pack.
+func (b *RecordBuilder) Build() (string, error) {
+	if len(b.errs) > 0 {
+		return "", b.errs[0]
+	}
+	return PackRecordFill(b.spec, b.values, b.fill)
+}
@@ -0,0 +1,50 @@
+package cnab
+
+import "testing"
+
+func TestRecordBuilderBuildsAPackedLine(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "kind", Start: 0, Length: 2, Default: "01"},
+		{Name: "name", Start: 2, Length: 5, Validators: []string{"required"}},
+	}}
+
+	line, err := NewRecordBuilder(spec).Set("kind", "02").Set("name", "BOB").Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	if line != "02BOB  " {
+		t.Errorf("got %q, want %q", line, "02BOB  ")
+	}
+}
+
+func TestRecordBuilderCollectsErrorsInsteadOfStopping(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "name", Start: 0, Length: 5, Validators: []string{"required"}},
+	}}
+
+	b := NewRecordBuilder(spec).Set("name", "").Set("missing", "x")
+	if len(b.Errors()) != 2 {
+		t.Fatalf("Errors() = %v, want 2 errors", b.Errors())
+	}
+	if _, err := b.Build(); err == nil {
+		t.Fatal("expected Build to fail after Set recorded errors")
+	}
+}
+
+func TestRecordBuilderValidatesAgainstPostFormattedValue(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "amount", Start: 0, Length: 4, PostFormat: []string{"zerofill:4"}, Validators: []string{"in:0001,0007"}},
+	}}
+
+	b := NewRecordBuilder(spec).Set("amount", "7")
+	if len(b.Errors()) != 0 {
+		t.Fatalf("Errors() = %v, want none: \"7\" zerofills to \"0007\", an allowed value", b.Errors())
+	}
+	line, err := b.Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	if line != "0007" {
+		t.Errorf("got %q, want %q", line, "0007")
+	}
+}
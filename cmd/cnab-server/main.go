@@ -0,0 +1,35 @@
+// Command cnab-server exposes the parsing engine as an HTTP service, for
+// non-Go callers that need to parse, pack, or validate CNAB records
+// without embedding the library. It loads a spec registry from a
+// directory of layout files at startup and selects a spec per request
+// by bank and version.
+package main
+
+import (
+	"flag"
+	"log"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"github.com/RafOSS-br/cnab-stream/server"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	specDir := flag.String("spec-dir", "specs", "directory of {bank}/{version}.json FileSpec files")
+	flag.Parse()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+
+	registry, err := server.LoadRegistryDir(os.DirFS(*specDir))
+	if err != nil {
+		log.Fatalf("cnab-server: loading spec registry from %s: %v", *specDir, err)
+	}
+
+	srv := server.New(registry, logger)
+	logger.Info("cnab-server: listening", "addr", *addr)
+	if err := http.ListenAndServe(*addr, srv); err != nil {
+		log.Fatalf("cnab-server: %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+// Command cnabctl is a small operator toolbox for inspecting CNAB files
+// against a spec on the command line, without writing a throwaway Go
+// program each time a reconciliation question comes up.
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/RafOSS-br/cnab-stream"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatal("cnabctl: expected a subcommand, e.g. \"inspect\"")
+	}
+	switch os.Args[1] {
+	case "inspect":
+		runInspect(os.Args[2:])
+	default:
+		log.Fatalf("cnabctl: unknown subcommand %q", os.Args[1])
+	}
+}
+
+func runInspect(args []string) {
+	fs := flag.NewFlagSet("inspect", flag.ExitOnError)
+	specPath := fs.String("spec", "", "path to a JSON RecordSpec file (see cnab.LoadSpec)")
+	filePath := fs.String("file", "", "path to the CNAB file to inspect")
+	line := fs.Int("line", 1, "1-based line number to inspect")
+	fs.Parse(args)
+
+	if *specPath == "" || *filePath == "" {
+		log.Fatal("cnabctl inspect: --spec and --file are required")
+	}
+
+	specFile, err := os.Open(*specPath)
+	if err != nil {
+		log.Fatalf("cnabctl inspect: %v", err)
+	}
+	defer specFile.Close()
+
+	spec, err := cnab.LoadSpec(context.Background(), specFile)
+	if err != nil {
+		log.Fatalf("cnabctl inspect: loading spec: %v", err)
+	}
+	spec, err = cnab.ResolveSpec(spec)
+	if err != nil {
+		log.Fatalf("cnabctl inspect: resolving spec: %v", err)
+	}
+
+	target, err := readLine(*filePath, *line)
+	if err != nil {
+		log.Fatalf("cnabctl inspect: %v", err)
+	}
+
+	if _, err := cnab.ParseRecordAt(spec, target, *line); err != nil {
+		fmt.Fprintf(os.Stderr, "cnabctl inspect: line %d failed to parse: %v\n", *line, err)
+	}
+
+	fmt.Printf("line %d (%d bytes):\n%s\n\n", *line, len(target), target)
+	fmt.Print(cnab.Annotate(spec, target))
+}
+
+// readLine returns the 1-based n'th line of the file at path.
+func readLine(path string, n int) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	current := 0
+	for scanner.Scan() {
+		current++
+		if current == n {
+			return scanner.Text(), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("file has fewer than %d lines", n)
+}
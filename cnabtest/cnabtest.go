@@ -0,0 +1,113 @@
+// Package cnabtest provides testing utilities for authors of CNAB specs:
+// random valid data generation, round-trip assertions, and (in
+// golden.go) a golden-file comparison helper.
+package cnabtest
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/RafOSS-br/cnab-stream"
+)
+
+// GenerateValues returns a map of randomly generated string values for
+// every field in spec, using r as the source of randomness.
+// Numeric-looking fields (validators "cpf", "cnpj", a digit-only "regex"
+// rule, or a PostFormat of "zerofill") get digit strings; everything
+// else gets upper-case alphanumeric text. Every value is exactly the
+// field's Length, so PackRecord followed by ParseRecord round-trips it
+// unchanged. The values are not checked against the field's Validators:
+// a "cpf" or "cnpj" field gets random digits without valid check digits,
+// and a "regex" rule only decides between digits and text.
+func GenerateValues(spec cnab.RecordSpec, r *rand.Rand) map[string]string {
+	values := make(map[string]string, len(spec.Fields))
+	for _, f := range spec.Fields {
+		values[f.Name] = randomFieldValue(f, r)
+	}
+	return values
+}
+
+func randomFieldValue(f cnab.FieldSpec, r *rand.Rand) string {
+	if f.Length <= 0 {
+		return ""
+	}
+	if isNumericField(f) {
+		return randomDigits(f.Length, r)
+	}
+	return randomAlnum(f.Length, r)
+}
+
+func isNumericField(f cnab.FieldSpec) bool {
+	for _, v := range f.Validators {
+		switch {
+		case v == "cpf", v == "cnpj":
+			return true
+		case strings.HasPrefix(v, "regex:"):
+			pattern := strings.TrimPrefix(v, "regex:")
+			if strings.Contains(pattern, "0-9") && !containsLetter(pattern) {
+				return true
+			}
+		}
+	}
+	for _, t := range f.PostFormat {
+		if strings.HasPrefix(t, "zerofill") {
+			return true
+		}
+	}
+	return false
+}
+
+func containsLetter(s string) bool {
+	for _, c := range s {
+		if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') {
+			return true
+		}
+	}
+	return false
+}
+
+const alnumAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+func randomAlnum(n int, r *rand.Rand) string {
+	buf := make([]byte, n)
+	for i := range buf {
+		buf[i] = alnumAlphabet[r.Intn(len(alnumAlphabet))]
+	}
+	return string(buf)
+}
+
+func randomDigits(n int, r *rand.Rand) string {
+	buf := make([]byte, n)
+	for i := range buf {
+		buf[i] = byte('0' + r.Intn(10))
+	}
+	return string(buf)
+}
+
+// AssertRoundTrip packs values with spec, parses the result, and reports
+// whether every field comes back exactly as packed (after the same
+// truncation PackRecord itself would apply). It returns a nil mismatches
+// slice when the round trip is exact.
+func AssertRoundTrip(spec cnab.RecordSpec, values map[string]string) (mismatches []string, err error) {
+	line, err := cnab.PackRecord(spec, values)
+	if err != nil {
+		return nil, err
+	}
+	rec, err := cnab.ParseRecord(spec, line)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range spec.Fields {
+		want := values[f.Name]
+		if len(want) > f.Length {
+			want = want[:f.Length]
+		} else {
+			want = want + strings.Repeat(" ", f.Length-len(want))
+		}
+		got, _ := rec.Get(f.Name)
+		if got != want {
+			mismatches = append(mismatches, f.Name)
+		}
+	}
+	return mismatches, nil
+}
@@ -0,0 +1,26 @@
+package cnabtest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/RafOSS-br/cnab-stream"
+)
+
+func TestGenerateValuesRoundTrips(t *testing.T) {
+	spec := cnab.RecordSpec{Fields: []cnab.FieldSpec{
+		{Name: "id", Start: 0, Length: 6, Validators: []string{"regex:^[0-9]+$"}},
+		{Name: "name", Start: 6, Length: 10},
+	}}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		values := GenerateValues(spec, r)
+		mismatches, err := AssertRoundTrip(spec, values)
+		if err != nil {
+			t.Fatalf("AssertRoundTrip: %v", err)
+		}
+		if len(mismatches) != 0 {
+			t.Fatalf("round-trip mismatch on fields %v for values %v", mismatches, values)
+		}
+	}
+}
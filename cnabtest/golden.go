@@ -0,0 +1,86 @@
+package cnabtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/RafOSS-br/cnab-stream"
+)
+
+// GoldenFile parses each line of inputFile against spec and compares the
+// resulting records, JSON-encoded, against expectedJSON. On mismatch it
+// fails t with a readable diff of the two JSON documents.
+//
+// Set the CNABTEST_UPDATE_GOLDEN environment variable to any non-empty
+// value to (re)write expectedJSON from the current parse output instead
+// of comparing, the usual pattern for accepting an intentional layout
+// change.
+func GoldenFile(t *testing.T, spec cnab.RecordSpec, inputFile, expectedJSON string) {
+	t.Helper()
+
+	input, err := os.ReadFile(inputFile)
+	if err != nil {
+		t.Fatalf("read input file: %v", err)
+	}
+
+	var records []*cnab.Record
+	for i, line := range splitLines(string(input)) {
+		if line == "" {
+			continue
+		}
+		rec, err := cnab.ParseRecordAt(spec, line, i+1)
+		if err != nil {
+			t.Fatalf("parse line %d of %s: %v", i+1, inputFile, err)
+		}
+		records = append(records, rec)
+	}
+
+	got, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		t.Fatalf("marshal parsed records: %v", err)
+	}
+	got = append(got, '\n')
+
+	if os.Getenv("CNABTEST_UPDATE_GOLDEN") != "" {
+		if err := os.WriteFile(expectedJSON, got, 0644); err != nil {
+			t.Fatalf("update golden file: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(expectedJSON)
+	if err != nil {
+		t.Fatalf("read golden file: %v", err)
+	}
+
+	if !bytes.Equal(bytes.TrimSpace(got), bytes.TrimSpace(want)) {
+		t.Fatalf("parsed output for %s does not match golden file %s:\n--- got ---\n%s\n--- want ---\n%s",
+			inputFile, expectedJSON, got, want)
+	}
+}
+
+func splitLines(s string) []string {
+	var lines []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\n' {
+			line := s[start:i]
+			line = trimCR(line)
+			lines = append(lines, line)
+			start = i + 1
+		}
+	}
+	if start < len(s) {
+		lines = append(lines, trimCR(s[start:]))
+	}
+	return lines
+}
+
+func trimCR(s string) string {
+	if len(s) > 0 && s[len(s)-1] == '\r' {
+		return s[:len(s)-1]
+	}
+	return s
+}
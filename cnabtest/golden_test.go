@@ -0,0 +1,15 @@
+package cnabtest
+
+import (
+	"testing"
+
+	"github.com/RafOSS-br/cnab-stream"
+)
+
+func TestGoldenFile(t *testing.T) {
+	spec := cnab.RecordSpec{Fields: []cnab.FieldSpec{
+		{Name: "id", Start: 0, Length: 6},
+		{Name: "name", Start: 6, Length: 8},
+	}}
+	GoldenFile(t, spec, "testdata/sample.txt", "testdata/sample.golden.json")
+}
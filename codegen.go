@@ -0,0 +1,45 @@
+package cnab
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GenerateStruct renders Go source for a struct with one string field per
+// entry in spec.Fields, in spec order, tagged with the original field
+// name for round-tripping via Record. It is intended to back a `go
+// generate`-style code generation step that turns a RecordSpec into a
+// typed Go struct.
+func GenerateStruct(packageName, structName string, spec RecordSpec) (string, error) {
+	if packageName == "" || structName == "" {
+		return "", fmt.Errorf("cnab: packageName and structName are required")
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "package %s\n\n", packageName)
+	fmt.Fprintf(&b, "// %s is generated from the %q record spec. Do not edit by hand.\n", structName, spec.Type)
+	fmt.Fprintf(&b, "type %s struct {\n", structName)
+	for _, f := range spec.Fields {
+		fmt.Fprintf(&b, "\t%s string `cnab:%q`\n", exportedName(f.Name), f.Name)
+	}
+	b.WriteString("}\n")
+	return b.String(), nil
+}
+
+// exportedName converts a snake_case or lowerCamel field name into an
+// exported Go identifier, e.g. "account_number" -> "AccountNumber".
+func exportedName(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '-' })
+	var b strings.Builder
+	for _, p := range parts {
+		if p == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(p[:1]))
+		b.WriteString(p[1:])
+	}
+	if b.Len() == 0 {
+		return "Field"
+	}
+	return b.String()
+}
@@ -0,0 +1,39 @@
+package cnab
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateStruct(t *testing.T) {
+	spec := RecordSpec{Type: "detail", Fields: []FieldSpec{
+		{Name: "account_number", Start: 0, Length: 6},
+		{Name: "name", Start: 6, Length: 20},
+	}}
+	src, err := GenerateStruct("records", "Detail", spec)
+	if err != nil {
+		t.Fatalf("GenerateStruct: %v", err)
+	}
+	if !strings.Contains(src, "package records") {
+		t.Error("missing package declaration")
+	}
+	if !strings.Contains(src, "type Detail struct") {
+		t.Error("missing struct declaration")
+	}
+	if !strings.Contains(src, `AccountNumber string `+"`cnab:\"account_number\"`") {
+		t.Errorf("missing AccountNumber field, got:\n%s", src)
+	}
+}
+
+func TestExportedName(t *testing.T) {
+	cases := map[string]string{
+		"account_number": "AccountNumber",
+		"name":           "Name",
+		"cpf-cnpj":       "CpfCnpj",
+	}
+	for in, want := range cases {
+		if got := exportedName(in); got != want {
+			t.Errorf("exportedName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
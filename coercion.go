@@ -0,0 +1,55 @@
+package cnab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CoercionPolicy controls how strictly CoerceInt and CoerceFloat convert
+// a field's raw string value to a numeric Go type, the same explicit-
+// policy style ParseDate already uses for zero dates via ZeroDatePolicy.
+type CoercionPolicy int
+
+const (
+	// CoercionStrict rejects a value that isn't already a clean base-10
+	// literal for the target type: no surrounding whitespace, and (for
+	// CoerceInt) no fractional part.
+	CoercionStrict CoercionPolicy = iota
+	// CoercionLenient trims surrounding whitespace before parsing, and
+	// lets CoerceInt accept a value with a fractional part by truncating
+	// it toward zero instead of erroring.
+	CoercionLenient
+)
+
+// CoerceInt converts value to an int64 according to policy.
+func CoerceInt(value string, policy CoercionPolicy) (int64, error) {
+	v := value
+	if policy == CoercionLenient {
+		v = strings.TrimSpace(v)
+	}
+	if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return n, nil
+	}
+	if policy != CoercionLenient {
+		return 0, fmt.Errorf("cnab: %q is not an integer", value)
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("cnab: %q is not a number", value)
+	}
+	return int64(f), nil
+}
+
+// CoerceFloat converts value to a float64 according to policy.
+func CoerceFloat(value string, policy CoercionPolicy) (float64, error) {
+	v := value
+	if policy == CoercionLenient {
+		v = strings.TrimSpace(v)
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("cnab: %q is not a number", value)
+	}
+	return f, nil
+}
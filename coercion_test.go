@@ -0,0 +1,37 @@
+package cnab
+
+import "testing"
+
+func TestCoerceIntStrictRejectsWhitespaceAndFraction(t *testing.T) {
+	if _, err := CoerceInt(" 12", CoercionStrict); err == nil {
+		t.Error("expected strict CoerceInt to reject surrounding whitespace")
+	}
+	if _, err := CoerceInt("12.5", CoercionStrict); err == nil {
+		t.Error("expected strict CoerceInt to reject a fractional value")
+	}
+	n, err := CoerceInt("12", CoercionStrict)
+	if err != nil || n != 12 {
+		t.Errorf("CoerceInt(12) = %d, %v, want 12, nil", n, err)
+	}
+}
+
+func TestCoerceIntLenientTrimsAndTruncates(t *testing.T) {
+	n, err := CoerceInt(" 12 ", CoercionLenient)
+	if err != nil || n != 12 {
+		t.Errorf("CoerceInt(\" 12 \") = %d, %v, want 12, nil", n, err)
+	}
+	n, err = CoerceInt("12.9", CoercionLenient)
+	if err != nil || n != 12 {
+		t.Errorf("CoerceInt(12.9) = %d, %v, want 12, nil (truncated toward zero)", n, err)
+	}
+}
+
+func TestCoerceFloat(t *testing.T) {
+	if _, err := CoerceFloat(" 1.5", CoercionStrict); err == nil {
+		t.Error("expected strict CoerceFloat to reject surrounding whitespace")
+	}
+	f, err := CoerceFloat(" 1.5 ", CoercionLenient)
+	if err != nil || f != 1.5 {
+		t.Errorf("CoerceFloat(\" 1.5 \") = %v, %v, want 1.5, nil", f, err)
+	}
+}
@@ -0,0 +1,64 @@
+package cnab
+
+// ExtendSpec builds a new RecordSpec from base, with overrides applied on
+// top: overrides whose Name matches an existing field replace it in
+// place, and overrides with a new Name are appended. This supports
+// spec inheritance/composition (a bank-specific layout extending a
+// common base layout) without repeating every field.
+func ExtendSpec(base RecordSpec, overrides ...FieldSpec) RecordSpec {
+	spec := RecordSpec{Type: base.Type, Fields: make([]FieldSpec, len(base.Fields)), Groups: base.Groups}
+	copy(spec.Fields, base.Fields)
+
+	index := make(map[string]int, len(spec.Fields))
+	for i, f := range spec.Fields {
+		index[f.Name] = i
+	}
+
+	for _, o := range overrides {
+		if i, ok := index[o.Name]; ok {
+			spec.Fields[i] = o
+			continue
+		}
+		index[o.Name] = len(spec.Fields)
+		spec.Fields = append(spec.Fields, o)
+	}
+	return spec
+}
+
+// mergeGroups applies overrides on top of existing using the same
+// override-by-name/append rules ExtendSpec uses for fields.
+func mergeGroups(existing, overrides []GroupSpec) []GroupSpec {
+	groups := make([]GroupSpec, len(existing))
+	copy(groups, existing)
+
+	index := make(map[string]int, len(groups))
+	for i, g := range groups {
+		index[g.Name] = i
+	}
+
+	for _, o := range overrides {
+		if i, ok := index[o.Name]; ok {
+			groups[i] = o
+			continue
+		}
+		index[o.Name] = len(groups)
+		groups = append(groups, o)
+	}
+	return groups
+}
+
+// ComposeSpecs merges multiple RecordSpecs into one, in order: each
+// subsequent spec's fields override or extend the result of the previous
+// ones, following the same rules as ExtendSpec. This lets a concrete
+// layout be assembled from a chain of "include" specs.
+func ComposeSpecs(specs ...RecordSpec) RecordSpec {
+	if len(specs) == 0 {
+		return RecordSpec{}
+	}
+	result := specs[0]
+	for _, s := range specs[1:] {
+		result = ExtendSpec(result, s.Fields...)
+		result.Groups = mergeGroups(result.Groups, s.Groups)
+	}
+	return result
+}
@@ -0,0 +1,63 @@
+package cnab
+
+import "testing"
+
+func TestExtendSpec(t *testing.T) {
+	base := RecordSpec{Type: "base", Fields: []FieldSpec{
+		{Name: "type", Start: 0, Length: 1},
+		{Name: "agency", Start: 1, Length: 4},
+	}}
+	extended := ExtendSpec(base, FieldSpec{Name: "agency", Start: 1, Length: 5}, FieldSpec{Name: "extra", Start: 6, Length: 2})
+
+	if len(extended.Fields) != 3 {
+		t.Fatalf("got %d fields, want 3", len(extended.Fields))
+	}
+	if extended.Fields[1].Length != 5 {
+		t.Errorf("agency length = %d, want 5 (overridden)", extended.Fields[1].Length)
+	}
+	if extended.Fields[2].Name != "extra" {
+		t.Errorf("fields[2].Name = %q, want extra", extended.Fields[2].Name)
+	}
+	// base must be untouched.
+	if base.Fields[1].Length != 4 {
+		t.Errorf("base was mutated: agency length = %d, want 4", base.Fields[1].Length)
+	}
+}
+
+func TestComposeSpecs(t *testing.T) {
+	common := RecordSpec{Fields: []FieldSpec{{Name: "type", Start: 0, Length: 1}}}
+	bankSpecific := RecordSpec{Fields: []FieldSpec{{Name: "agency", Start: 1, Length: 4}}}
+
+	composed := ComposeSpecs(common, bankSpecific)
+	if len(composed.Fields) != 2 {
+		t.Fatalf("got %d fields, want 2", len(composed.Fields))
+	}
+}
+
+func TestExtendSpecPreservesGroups(t *testing.T) {
+	base := RecordSpec{
+		Fields: []FieldSpec{{Name: "agency", Start: 0, Length: 4}},
+		Groups: []GroupSpec{{Name: "items", Start: 4, ItemLength: 2, Occurs: 3}},
+	}
+	extended := ExtendSpec(base, FieldSpec{Name: "extra", Start: 10, Length: 2})
+
+	if len(extended.Groups) != 1 || extended.Groups[0].Name != "items" {
+		t.Errorf("Groups = %+v, want the base Groups preserved", extended.Groups)
+	}
+}
+
+func TestComposeSpecsMergesGroups(t *testing.T) {
+	common := RecordSpec{
+		Fields: []FieldSpec{{Name: "type", Start: 0, Length: 1}},
+		Groups: []GroupSpec{{Name: "items", Start: 4, ItemLength: 2, Occurs: 3}},
+	}
+	bankSpecific := RecordSpec{
+		Fields: []FieldSpec{{Name: "agency", Start: 1, Length: 4}},
+		Groups: []GroupSpec{{Name: "extras", Start: 10, ItemLength: 1, Occurs: 2}},
+	}
+
+	composed := ComposeSpecs(common, bankSpecific)
+	if len(composed.Groups) != 2 {
+		t.Fatalf("Groups = %+v, want both common's and bankSpecific's groups", composed.Groups)
+	}
+}
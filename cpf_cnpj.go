@@ -0,0 +1,98 @@
+package cnab
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidateCPF reports whether s (11 digits, optionally formatted with
+// punctuation) is a CPF with valid check digits.
+func ValidateCPF(s string) bool {
+	digits := onlyDigits(s)
+	if len(digits) != 11 || allSameDigit(digits) {
+		return false
+	}
+	d1 := cpfCheckDigit(digits[:9], 10)
+	d2 := cpfCheckDigit(digits[:9]+string(d1), 11)
+	return digits[9] == d1 && digits[10] == d2
+}
+
+// ValidateCNPJ reports whether s (14 digits, optionally formatted with
+// punctuation) is a CNPJ with valid check digits.
+func ValidateCNPJ(s string) bool {
+	digits := onlyDigits(s)
+	if len(digits) != 14 || allSameDigit(digits) {
+		return false
+	}
+	weights1 := []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+	weights2 := []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+	d1 := modCheckDigit(digits[:12], weights1)
+	d2 := modCheckDigit(digits[:12]+string(d1), weights2)
+	return digits[12] == d1 && digits[13] == d2
+}
+
+func onlyDigits(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func allSameDigit(s string) bool {
+	for i := 1; i < len(s); i++ {
+		if s[i] != s[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// cpfCheckDigit computes one CPF check digit from prefix, using
+// descending weights starting at startWeight.
+func cpfCheckDigit(prefix string, startWeight int) byte {
+	sum := 0
+	weight := startWeight
+	for i := 0; i < len(prefix); i++ {
+		sum += int(prefix[i]-'0') * weight
+		weight--
+	}
+	r := sum % 11
+	if r < 2 {
+		return '0'
+	}
+	return byte('0' + (11 - r))
+}
+
+func modCheckDigit(prefix string, weights []int) byte {
+	sum := 0
+	for i := 0; i < len(prefix); i++ {
+		sum += int(prefix[i]-'0') * weights[i]
+	}
+	r := sum % 11
+	if r < 2 {
+		return '0'
+	}
+	return byte('0' + (11 - r))
+}
+
+func init() {
+	RegisterValidator("cpf", func(string) (Validator, error) {
+		return func(v string) error {
+			if !ValidateCPF(v) {
+				return fmt.Errorf("%q is not a valid CPF", v)
+			}
+			return nil
+		}, nil
+	})
+	RegisterValidator("cnpj", func(string) (Validator, error) {
+		return func(v string) error {
+			if !ValidateCNPJ(v) {
+				return fmt.Errorf("%q is not a valid CNPJ", v)
+			}
+			return nil
+		}, nil
+	})
+}
@@ -0,0 +1,34 @@
+package cnab
+
+import "testing"
+
+func TestValidateCPF(t *testing.T) {
+	if !ValidateCPF("111.444.777-35") {
+		t.Error("expected valid CPF")
+	}
+	if ValidateCPF("111.111.111-11") {
+		t.Error("expected invalid CPF (repeated digits)")
+	}
+	if ValidateCPF("123.456.789-00") {
+		t.Error("expected invalid CPF (bad check digits)")
+	}
+}
+
+func TestValidateCNPJ(t *testing.T) {
+	if !ValidateCNPJ("11.222.333/0001-81") {
+		t.Error("expected valid CNPJ")
+	}
+	if ValidateCNPJ("11.111.111/1111-11") {
+		t.Error("expected invalid CNPJ (repeated digits)")
+	}
+}
+
+func TestCPFValidatorRule(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "doc", Start: 0, Length: 11, Validators: []string{"cpf"}}}}
+	if _, err := ParseRecord(spec, "11144477735"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := ParseRecord(spec, "00000000000"); err == nil {
+		t.Fatal("expected validation error")
+	}
+}
@@ -0,0 +1,38 @@
+package cnab
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// WriteCSV writes records to w as CSV, using the field names of the first
+// record as the header row. All records are expected to share the same
+// field set and order (e.g. all parsed against the same RecordSpec).
+func WriteCSV(w io.Writer, records []*Record) error {
+	cw := csv.NewWriter(w)
+	if len(records) == 0 {
+		cw.Flush()
+		return cw.Error()
+	}
+
+	header := make([]string, 0, len(records[0].Fields()))
+	for _, fv := range records[0].Fields() {
+		header = append(header, fv.Name)
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, rec := range records {
+		row := make([]string, len(header))
+		for i, name := range header {
+			row[i], _ = rec.Get(name)
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
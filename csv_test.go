@@ -0,0 +1,24 @@
+package cnab
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteCSV(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "agency", Start: 0, Length: 4},
+		{Name: "name", Start: 4, Length: 5},
+	}}
+	r1, _ := ParseRecord(spec, "0001ALICE")
+	r2, _ := ParseRecord(spec, "0002BOB  ")
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, []*Record{r1, r2}); err != nil {
+		t.Fatalf("WriteCSV: %v", err)
+	}
+	want := "agency,name\n0001,ALICE\n0002,BOB  \n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
@@ -0,0 +1,69 @@
+package cnab
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ZeroDatePolicy controls how ParseDate treats an all-zero date value
+// (e.g. "00000000"), a common convention in CNAB files for "no date".
+type ZeroDatePolicy int
+
+const (
+	// ZeroDateError causes ParseDate to return an error for a zero date.
+	ZeroDateError ZeroDatePolicy = iota
+	// ZeroDateNil causes ParseDate to return a zero time.Time and no
+	// error for a zero date; callers distinguish it via time.Time.IsZero.
+	ZeroDateNil
+	// ZeroDateEpoch causes ParseDate to return the Unix epoch for a zero
+	// date.
+	ZeroDateEpoch
+)
+
+// ParseDate parses value as a date using layout (a time.Parse reference
+// layout) in UTC. If value consists entirely of '0' characters, it is
+// handled according to policy instead of being parsed. It is equivalent
+// to calling ParseDateInLocation with time.UTC.
+func ParseDate(value, layout string, policy ZeroDatePolicy) (time.Time, error) {
+	return ParseDateInLocation(value, layout, policy, time.UTC)
+}
+
+// ParseDateInLocation behaves like ParseDate, but interprets value in
+// loc instead of UTC. Most CNAB date fields carry no time-of-day
+// component, but a caller combining a date field with a separate time
+// field into a single timestamp needs the pair anchored to the same
+// zone the bank's clock actually used, to avoid off-by-one-day bugs
+// around midnight.
+func ParseDateInLocation(value, layout string, policy ZeroDatePolicy, loc *time.Location) (time.Time, error) {
+	if isAllZero(value) {
+		switch policy {
+		case ZeroDateNil:
+			return time.Time{}, nil
+		case ZeroDateEpoch:
+			return time.Unix(0, 0).In(loc), nil
+		default:
+			return time.Time{}, fmt.Errorf("cnab: zero date %q is not allowed", value)
+		}
+	}
+	t, err := time.ParseInLocation(layout, value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cnab: invalid date %q: %w", value, err)
+	}
+	return t, nil
+}
+
+// FormatDateInLocation normalizes t to loc before formatting it with
+// layout (a time.Format reference layout), so that a time.Time carrying
+// a different zone (e.g. one parsed elsewhere as UTC) does not shift to
+// the wrong calendar day when rendered into a fixed-width date field.
+func FormatDateInLocation(t time.Time, layout string, loc *time.Location) string {
+	return t.In(loc).Format(layout)
+}
+
+func isAllZero(s string) bool {
+	if s == "" {
+		return false
+	}
+	return strings.Trim(s, "0") == ""
+}
@@ -0,0 +1,56 @@
+package cnab
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDateValid(t *testing.T) {
+	tm, err := ParseDate("20240131", "20060102", ZeroDateError)
+	if err != nil {
+		t.Fatalf("ParseDate: %v", err)
+	}
+	if tm.Year() != 2024 || tm.Month() != 1 || tm.Day() != 31 {
+		t.Errorf("got %v", tm)
+	}
+}
+
+func TestParseDateZeroPolicies(t *testing.T) {
+	if _, err := ParseDate("00000000", "20060102", ZeroDateError); err == nil {
+		t.Fatal("expected error for zero date with ZeroDateError")
+	}
+	tm, err := ParseDate("00000000", "20060102", ZeroDateNil)
+	if err != nil || !tm.IsZero() {
+		t.Errorf("ZeroDateNil: got %v, %v", tm, err)
+	}
+	tm, err = ParseDate("00000000", "20060102", ZeroDateEpoch)
+	if err != nil || tm.Unix() != 0 {
+		t.Errorf("ZeroDateEpoch: got %v, %v", tm, err)
+	}
+}
+
+func TestParseDateInLocationInterpretsInGivenZone(t *testing.T) {
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skipf("America/Sao_Paulo tzdata not available: %v", err)
+	}
+	tm, err := ParseDateInLocation("20240131", "20060102", ZeroDateError, loc)
+	if err != nil {
+		t.Fatalf("ParseDateInLocation: %v", err)
+	}
+	if tm.Location().String() != loc.String() {
+		t.Errorf("Location() = %v, want %v", tm.Location(), loc)
+	}
+}
+
+func TestFormatDateInLocationNormalizesBeforeFormatting(t *testing.T) {
+	utcMidnight := time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC)
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skipf("America/Sao_Paulo tzdata not available: %v", err)
+	}
+	got := FormatDateInLocation(utcMidnight, "20060102", loc)
+	if got != "20231231" {
+		t.Errorf("FormatDateInLocation = %q, want %q (previous day in São Paulo time)", got, "20231231")
+	}
+}
@@ -0,0 +1,82 @@
+package cnab
+
+import (
+	"fmt"
+	"time"
+)
+
+// convertDateFormat translates a shorthand date token, as used by
+// CNAB400 layouts, into a Go time reference layout. Supported tokens:
+// "DDMMYY", "DDMMYYYY", "YYMMDD", "YYYYMMDD", and "YYDDD" (a two-digit
+// year plus zero-padded day-of-year, the Julian form some CNAB400
+// retorno files use for occurrence dates).
+func convertDateFormat(token string) (string, error) {
+	switch token {
+	case "DDMMYY":
+		return "020106", nil
+	case "DDMMYYYY":
+		return "02012006", nil
+	case "YYMMDD":
+		return "060102", nil
+	case "YYYYMMDD":
+		return "20060102", nil
+	case "YYDDD":
+		return "06002", nil
+	default:
+		return "", fmt.Errorf("cnab: unknown date format token %q", token)
+	}
+}
+
+// hasTwoDigitYear reports whether token's Go layout includes a two-digit
+// (rather than four-digit) year component.
+func hasTwoDigitYear(token string) bool {
+	switch token {
+	case "DDMMYY", "YYMMDD", "YYDDD":
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseDateToken parses value against token (see convertDateFormat),
+// remapping a two-digit year against a custom century window instead of
+// Go's fixed 1969-2068 pivot. pivot is the largest two-digit year placed
+// in the 2000s: a parsed year <= pivot is treated as 20xx, and one
+// > pivot as 19xx — e.g. pivot=30 treats "29" as 2029 but "31" as 1931.
+// pivot is ignored for tokens with a four-digit year.
+func ParseDateToken(value, token string, pivot int, loc *time.Location) (time.Time, error) {
+	layout, err := convertDateFormat(token)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := time.ParseInLocation(layout, value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cnab: invalid date %q for token %q: %w", value, token, err)
+	}
+	if !hasTwoDigitYear(token) {
+		return t, nil
+	}
+	return remapCentury(t, pivot), nil
+}
+
+// remapCentury rewrites t's year using yy := t.Year() % 100 (recovering
+// the original two-digit value regardless of which century Go's own
+// fixed pivot assigned it) and pivot's century window.
+func remapCentury(t time.Time, pivot int) time.Time {
+	yy := t.Year() % 100
+	year := 1900 + yy
+	if yy <= pivot {
+		year = 2000 + yy
+	}
+	return time.Date(year, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// FormatDateToken formats t, normalized to loc, using token's layout
+// (see convertDateFormat).
+func FormatDateToken(t time.Time, token string, loc *time.Location) (string, error) {
+	layout, err := convertDateFormat(token)
+	if err != nil {
+		return "", err
+	}
+	return t.In(loc).Format(layout), nil
+}
@@ -0,0 +1,56 @@
+package cnab
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDateTokenRoundTrip(t *testing.T) {
+	cases := []struct {
+		token string
+		value string
+	}{
+		{"DDMMYY", "310124"},
+		{"DDMMYYYY", "31012024"},
+		{"YYMMDD", "240131"},
+		{"YYYYMMDD", "20240131"},
+		{"YYDDD", "24032"},
+	}
+	for _, c := range cases {
+		tm, err := ParseDateToken(c.value, c.token, 68, time.UTC)
+		if err != nil {
+			t.Fatalf("%s: ParseDateToken(%q): %v", c.token, c.value, err)
+		}
+		got, err := FormatDateToken(tm, c.token, time.UTC)
+		if err != nil {
+			t.Fatalf("%s: FormatDateToken: %v", c.token, err)
+		}
+		if got != c.value {
+			t.Errorf("%s: round trip = %q, want %q", c.token, got, c.value)
+		}
+	}
+}
+
+func TestParseDateTokenCenturyWindow(t *testing.T) {
+	tm, err := ParseDateToken("290101", "YYMMDD", 30, time.UTC)
+	if err != nil {
+		t.Fatalf("ParseDateToken: %v", err)
+	}
+	if tm.Year() != 2029 {
+		t.Errorf("year 29 with pivot 30: got %d, want 2029", tm.Year())
+	}
+
+	tm, err = ParseDateToken("310101", "YYMMDD", 30, time.UTC)
+	if err != nil {
+		t.Fatalf("ParseDateToken: %v", err)
+	}
+	if tm.Year() != 1931 {
+		t.Errorf("year 31 with pivot 30: got %d, want 1931", tm.Year())
+	}
+}
+
+func TestParseDateTokenUnknownToken(t *testing.T) {
+	if _, err := ParseDateToken("310124", "MMDDYY", 68, time.UTC); err == nil {
+		t.Fatal("expected an error for an unsupported token")
+	}
+}
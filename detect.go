@@ -0,0 +1,45 @@
+package cnab
+
+import "sort"
+
+// DetectLayout inspects header, a file's raw header line, against every
+// FileSpec registered in reg and returns the best match: the first
+// registered (bank, version) whose Header record spec both matches
+// header's length and parses header without a validation error. This is
+// aimed at generic ingestion endpoints that must accept files from many
+// banks without the caller naming a spec up front — record length and
+// content are usually enough to tell a Itaú header from a Bradesco one.
+//
+// Candidates are tried in a deterministic order (bank, then version,
+// both sorted) so that DetectLayout's result does not depend on map
+// iteration order when more than one spec matches.
+func DetectLayout(header []byte, reg *Registry) (bank, version string, spec FileSpec, ok bool) {
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+
+	banks := make([]string, 0, len(reg.specs))
+	for b := range reg.specs {
+		banks = append(banks, b)
+	}
+	sort.Strings(banks)
+
+	for _, b := range banks {
+		versions := make([]string, 0, len(reg.specs[b]))
+		for v := range reg.specs[b] {
+			versions = append(versions, v)
+		}
+		sort.Strings(versions)
+
+		for _, v := range versions {
+			s := reg.specs[b][v]
+			if total := s.Header.TotalLength(); total != 0 && total != len(header) {
+				continue
+			}
+			if _, err := ParseRecord(s.Header, string(header)); err != nil {
+				continue
+			}
+			return b, v, s, true
+		}
+	}
+	return "", "", FileSpec{}, false
+}
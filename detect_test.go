@@ -0,0 +1,38 @@
+package cnab
+
+import "testing"
+
+func TestDetectLayoutMatchesByLengthAndContent(t *testing.T) {
+	reg := NewRegistry()
+	reg.Register("bankA", "v1", FileSpec{
+		Header: RecordSpec{Fields: []FieldSpec{
+			{Name: "tipo", Start: 0, Length: 1, Validators: []string{"in:0"}},
+			{Name: "banco", Start: 1, Length: 3},
+		}},
+	})
+	reg.Register("bankB", "v1", FileSpec{
+		Header: RecordSpec{Fields: []FieldSpec{
+			{Name: "tipo", Start: 0, Length: 1, Validators: []string{"in:H"}},
+			{Name: "banco", Start: 1, Length: 5},
+		}},
+	})
+
+	bank, version, spec, ok := DetectLayout([]byte("0341"), reg)
+	if !ok || bank != "bankA" || version != "v1" {
+		t.Fatalf("DetectLayout = %q %q %v, want bankA v1 true", bank, version, ok)
+	}
+	if _, found := spec.Header.FieldByName("banco"); !found {
+		t.Fatal("returned spec does not match bankA's registered header")
+	}
+}
+
+func TestDetectLayoutNoMatch(t *testing.T) {
+	reg := NewRegistry()
+	reg.Register("bankA", "v1", FileSpec{
+		Header: RecordSpec{Fields: []FieldSpec{{Name: "tipo", Start: 0, Length: 1, Validators: []string{"in:0"}}}},
+	})
+
+	if _, _, _, ok := DetectLayout([]byte("9"), reg); ok {
+		t.Fatal("expected no match for a header that fails every registered spec")
+	}
+}
@@ -0,0 +1,51 @@
+package cnab
+
+import "sync"
+
+// Dialect bundles everything a bank-specific CNAB integration needs beyond
+// a bare FileSpec: the layout itself and lookup tables for bank-specific
+// codes (occurrence/return codes, mostly, since their numbering is not
+// standardized across banks even within the same CNAB version). Bank
+// quirks live in a Dialect implementation under dialect/<bank> rather
+// than as forks of a shared spec, so upgrading a shared field format
+// doesn't require touching every bank's copy.
+type Dialect interface {
+	// Name identifies the bank, e.g. "itau" or "bradesco".
+	Name() string
+	// Version identifies the layout version, e.g. "cnab400" or "cnab240".
+	Version() string
+	// Spec returns the FileSpec describing this dialect's file layout.
+	Spec() FileSpec
+	// OccurrenceCode translates a bank-specific occurrence/return code
+	// into a human-readable description. It reports false for codes the
+	// dialect does not recognize.
+	OccurrenceCode(code string) (string, bool)
+}
+
+// RegisterDialect registers d's Spec with DefaultRegistry under d.Name()
+// and d.Version(), and remembers d itself so LookupDialect can retrieve
+// the full Dialect (occurrence codes included) rather than just its spec.
+func RegisterDialect(d Dialect) {
+	DefaultRegistry.Register(d.Name(), d.Version(), d.Spec())
+	dialectsMu.Lock()
+	defer dialectsMu.Unlock()
+	dialects[dialectKey{d.Name(), d.Version()}] = d
+}
+
+// LookupDialect returns the Dialect registered for (bank, version).
+func LookupDialect(bank, version string) (Dialect, bool) {
+	dialectsMu.RLock()
+	defer dialectsMu.RUnlock()
+	d, ok := dialects[dialectKey{bank, version}]
+	return d, ok
+}
+
+type dialectKey struct {
+	bank    string
+	version string
+}
+
+var (
+	dialectsMu sync.RWMutex
+	dialects   = make(map[dialectKey]Dialect)
+)
@@ -0,0 +1,28 @@
+package bradesco
+
+import (
+	"testing"
+
+	cnab "github.com/RafOSS-br/cnab-stream"
+)
+
+func TestDialectRegistersOnImport(t *testing.T) {
+	spec, ok := cnab.Lookup("bradesco", "cnab400")
+	if !ok {
+		t.Fatal("expected bradesco's spec to be registered on import")
+	}
+	if _, found := spec.Detail.FieldByName("nosso_numero"); !found {
+		t.Fatal("registered spec is missing the nosso_numero field")
+	}
+}
+
+func TestOccurrenceCode(t *testing.T) {
+	d := Dialect{}
+	desc, ok := d.OccurrenceCode("02")
+	if !ok || desc != "registro recusado" {
+		t.Fatalf("OccurrenceCode(02) = %q, %v", desc, ok)
+	}
+	if _, ok := d.OccurrenceCode("99"); ok {
+		t.Fatal("expected an unknown code to report false")
+	}
+}
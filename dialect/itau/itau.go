@@ -0,0 +1,58 @@
+// Package itau implements the cnab.Dialect for Itaú's CNAB400 cobrança
+// layout, registering itself with cnab.DefaultRegistry on import.
+package itau
+
+import "github.com/RafOSS-br/cnab-stream"
+
+// Dialect is the Itaú CNAB400 cobrança dialect.
+type Dialect struct{}
+
+func init() {
+	cnab.RegisterDialect(Dialect{})
+}
+
+// Name implements cnab.Dialect.
+func (Dialect) Name() string { return "itau" }
+
+// Version implements cnab.Dialect.
+func (Dialect) Version() string { return "cnab400" }
+
+// Spec implements cnab.Dialect.
+func (Dialect) Spec() cnab.FileSpec {
+	return cnab.FileSpec{
+		Header: cnab.RecordSpec{Fields: []cnab.FieldSpec{
+			{Name: "tipo_registro", Start: 0, Length: 1, Default: "0"},
+			{Name: "codigo_banco", Start: 76, Length: 3, Default: "341"},
+		}},
+		Detail: cnab.RecordSpec{Fields: []cnab.FieldSpec{
+			{Name: "tipo_registro", Start: 0, Length: 1, Default: "1"},
+			{Name: "nosso_numero", Start: 37, Length: 8},
+			{Name: "carteira", Start: 107, Length: 3},
+			{Name: "ocorrencia", Start: 108, Length: 2},
+			{Name: "valor_titulo", Start: 152, Length: 13},
+		}},
+		Trailer: cnab.RecordSpec{Fields: []cnab.FieldSpec{
+			{Name: "tipo_registro", Start: 0, Length: 1, Default: "9"},
+		}},
+		SeqField:           "nosso_numero",
+		AmountField:        "valor_titulo",
+		DuplicateKeyFields: []string{"nosso_numero"},
+	}
+}
+
+// occurrenceCodes maps Itaú's CNAB400 retorno occurrence codes to their
+// FEBRABAN description.
+var occurrenceCodes = map[string]string{
+	"02": "confirmação de entrada de título",
+	"03": "confirmação de pedido de alteração",
+	"06": "liquidação normal",
+	"09": "baixado automaticamente via arquivo",
+	"10": "baixado conforme instruções da agência",
+	"12": "confirmação de abatimento",
+}
+
+// OccurrenceCode implements cnab.Dialect.
+func (Dialect) OccurrenceCode(code string) (string, bool) {
+	desc, ok := occurrenceCodes[code]
+	return desc, ok
+}
@@ -0,0 +1,44 @@
+package cnab
+
+import "testing"
+
+type fakeDialect struct {
+	name, version string
+	occurrences   map[string]string
+}
+
+func (d fakeDialect) Name() string    { return d.name }
+func (d fakeDialect) Version() string { return d.version }
+func (d fakeDialect) Spec() FileSpec {
+	return FileSpec{Header: RecordSpec{Fields: []FieldSpec{{Name: "type", Start: 0, Length: 1}}}}
+}
+func (d fakeDialect) OccurrenceCode(code string) (string, bool) {
+	desc, ok := d.occurrences[code]
+	return desc, ok
+}
+
+func TestRegisterDialectAlsoRegistersSpecAndOccurrenceCodes(t *testing.T) {
+	d := fakeDialect{name: "fakebank", version: "cnab400", occurrences: map[string]string{"06": "liquidado"}}
+	RegisterDialect(d)
+
+	spec, ok := Lookup("fakebank", "cnab400")
+	if !ok {
+		t.Fatal("expected the dialect's spec to be registered in DefaultRegistry")
+	}
+	if _, found := spec.Header.FieldByName("type"); !found {
+		t.Fatal("registered spec does not match the dialect's Spec()")
+	}
+
+	got, ok := LookupDialect("fakebank", "cnab400")
+	if !ok {
+		t.Fatal("expected LookupDialect to find the registered dialect")
+	}
+	desc, ok := got.OccurrenceCode("06")
+	if !ok || desc != "liquidado" {
+		t.Fatalf("OccurrenceCode(06) = %q, %v, want \"liquidado\", true", desc, ok)
+	}
+
+	if _, ok := got.OccurrenceCode("99"); ok {
+		t.Fatal("expected an unknown occurrence code to report false")
+	}
+}
@@ -0,0 +1,68 @@
+package cnab
+
+import "fmt"
+
+// FieldDiff describes a single field whose value differs between two
+// versions of a record.
+type FieldDiff struct {
+	Name string
+	Old  string
+	New  string
+}
+
+// LineDiff collects the field-level differences found on one line.
+type LineDiff struct {
+	Line   int
+	Fields []FieldDiff
+}
+
+// DiffLines compares two sets of lines field-by-field according to spec,
+// returning one LineDiff per line that differs. Lines present in only one
+// side (when len(a) != len(b)) are reported with a single FieldDiff named
+// "(line)" holding the raw line content, since they can't be parsed
+// against their counterpart.
+func DiffLines(spec RecordSpec, a, b []string) ([]LineDiff, error) {
+	var diffs []LineDiff
+	n := len(a)
+	if len(b) > n {
+		n = len(b)
+	}
+
+	for i := 0; i < n; i++ {
+		lineNo := i + 1
+		if i >= len(a) {
+			diffs = append(diffs, LineDiff{Line: lineNo, Fields: []FieldDiff{{Name: "(line)", Old: "", New: b[i]}}})
+			continue
+		}
+		if i >= len(b) {
+			diffs = append(diffs, LineDiff{Line: lineNo, Fields: []FieldDiff{{Name: "(line)", Old: a[i], New: ""}}})
+			continue
+		}
+		if a[i] == b[i] {
+			continue
+		}
+
+		recA, err := ParseRecordAt(spec, a[i], lineNo)
+		if err != nil {
+			return nil, fmt.Errorf("diff line %d (a): %w", lineNo, err)
+		}
+		recB, err := ParseRecordAt(spec, b[i], lineNo)
+		if err != nil {
+			return nil, fmt.Errorf("diff line %d (b): %w", lineNo, err)
+		}
+
+		var fields []FieldDiff
+		for _, f := range spec.Fields {
+			oldV, _ := recA.Get(f.Name)
+			newV, _ := recB.Get(f.Name)
+			if oldV != newV {
+				fields = append(fields, FieldDiff{Name: f.Name, Old: oldV, New: newV})
+			}
+		}
+		if len(fields) > 0 {
+			diffs = append(diffs, LineDiff{Line: lineNo, Fields: fields})
+		}
+	}
+
+	return diffs, nil
+}
@@ -0,0 +1,37 @@
+package cnab
+
+import "testing"
+
+func TestDiffLines(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "agency", Start: 0, Length: 4},
+		{Name: "name", Start: 4, Length: 5},
+	}}
+	a := []string{"0001ALICE", "0002BOB  "}
+	b := []string{"0001ALICE", "0002CARL "}
+
+	diffs, err := DiffLines(spec, a, b)
+	if err != nil {
+		t.Fatalf("DiffLines: %v", err)
+	}
+	if len(diffs) != 1 {
+		t.Fatalf("got %d diffs, want 1", len(diffs))
+	}
+	if diffs[0].Line != 2 {
+		t.Errorf("diff line = %d, want 2", diffs[0].Line)
+	}
+	if len(diffs[0].Fields) != 1 || diffs[0].Fields[0].Name != "name" {
+		t.Errorf("unexpected fields: %+v", diffs[0].Fields)
+	}
+}
+
+func TestDiffLinesLengthMismatch(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "name", Start: 0, Length: 5}}}
+	diffs, err := DiffLines(spec, []string{"ALICE"}, []string{"ALICE", "BOB  "})
+	if err != nil {
+		t.Fatalf("DiffLines: %v", err)
+	}
+	if len(diffs) != 1 || diffs[0].Fields[0].New != "BOB  " {
+		t.Errorf("unexpected diffs: %+v", diffs)
+	}
+}
@@ -0,0 +1,56 @@
+package cnab
+
+// EBCDIC support for mainframe-originated CNAB files, using the IBM037
+// (EBCDIC US/Canada) code page, the most common encoding for legacy
+// mainframe-generated remessa/retorno files.
+
+// ebcdicToASCII maps each EBCDIC byte (code page 037) to its ASCII
+// equivalent. Bytes with no printable ASCII equivalent map to 0x3F ('?').
+var ebcdicToASCII = [256]byte{
+	0x00, 0x01, 0x02, 0x03, 0x3F, 0x09, 0x3F, 0x7F, 0x3F, 0x3F, 0x3F, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F,
+	0x10, 0x11, 0x12, 0x13, 0x3F, 0x3F, 0x08, 0x3F, 0x18, 0x19, 0x3F, 0x3F, 0x1C, 0x1D, 0x1E, 0x1F,
+	0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x0A, 0x17, 0x1B, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x05, 0x06, 0x07,
+	0x3F, 0x3F, 0x16, 0x3F, 0x3F, 0x3F, 0x3F, 0x04, 0x3F, 0x3F, 0x3F, 0x3F, 0x14, 0x15, 0x3F, 0x1A,
+	0x20, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x2E, 0x3C, 0x28, 0x2B, 0x7C,
+	0x26, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x21, 0x24, 0x2A, 0x29, 0x3B, 0x3F,
+	0x2D, 0x2F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x2C, 0x25, 0x5F, 0x3E, 0x3F,
+	0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x60, 0x3A, 0x23, 0x40, 0x27, 0x3D, 0x22,
+	0x3F, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67, 0x68, 0x69, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+	0x3F, 0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F, 0x70, 0x71, 0x72, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+	0x3F, 0x7E, 0x73, 0x74, 0x75, 0x76, 0x77, 0x78, 0x79, 0x7A, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+	0x5E, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x5B, 0x5D, 0x3F, 0x3F, 0x3F, 0x3F,
+	0x7B, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x49, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+	0x7D, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F, 0x50, 0x51, 0x52, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+	0x5C, 0x3F, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x59, 0x5A, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+	0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+}
+
+// asciiToEBCDIC is the reverse mapping, built once from ebcdicToASCII.
+var asciiToEBCDIC = func() [256]byte {
+	var t [256]byte
+	for i := range t {
+		t[i] = 0x3F // EBCDIC SUB, which decodes to ASCII SUB (0x1A)
+	}
+	for e, a := range ebcdicToASCII {
+		t[a] = byte(e)
+	}
+	return t
+}()
+
+// DecodeEBCDIC converts EBCDIC-encoded bytes (IBM037) to an ASCII string.
+func DecodeEBCDIC(b []byte) string {
+	out := make([]byte, len(b))
+	for i, c := range b {
+		out[i] = ebcdicToASCII[c]
+	}
+	return string(out)
+}
+
+// EncodeEBCDIC converts an ASCII string to EBCDIC-encoded bytes (IBM037).
+func EncodeEBCDIC(s string) []byte {
+	out := make([]byte, len(s))
+	for i := 0; i < len(s); i++ {
+		out[i] = asciiToEBCDIC[s[i]]
+	}
+	return out
+}
@@ -0,0 +1,16 @@
+package cnab
+
+import "testing"
+
+func TestEBCDICRoundTrip(t *testing.T) {
+	original := "HELLO 123"
+	encoded := EncodeEBCDIC(original)
+	decoded := DecodeEBCDIC(encoded)
+	if decoded != original {
+		t.Errorf("round trip got %q, want %q", decoded, original)
+	}
+	// 'H' in EBCDIC (cp037) is 0xC8.
+	if encoded[0] != 0xC8 {
+		t.Errorf("encoded 'H' = 0x%02X, want 0xC8", encoded[0])
+	}
+}
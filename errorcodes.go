@@ -0,0 +1,38 @@
+package cnab
+
+import "errors"
+
+// Error codes attached to *ParseError via its Code field, stable across
+// releases so API consumers and support teams can match on a code rather
+// than parsing Error()'s human-readable message, which may be reworded
+// or (once localized) translated.
+const (
+	// CodeFieldOutOfBounds marks a field or group whose declared range
+	// extends beyond the end of the line or record being parsed.
+	CodeFieldOutOfBounds = "CNAB-001"
+	// CodeFieldOverflow marks a value rejected by FieldSpec.OnOverflow =
+	// "error" (or WithStrictMode) for exceeding its field's Length.
+	CodeFieldOverflow = "CNAB-002"
+	// CodeValidationFailed marks a value rejected by one of the field's
+	// Validators rules.
+	CodeValidationFailed = "CNAB-003"
+	// CodeInvalidBigInt marks a Type: "bigint" field holding something
+	// other than base-10 digits.
+	CodeInvalidBigInt = "CNAB-004"
+	// CodeStructuralMismatch marks a file-level structural problem found
+	// while reconstructing a hierarchy (a trailer with no matching
+	// header, a detail record outside any batch, and similar).
+	CodeStructuralMismatch = "CNAB-005"
+)
+
+// ErrorCode returns the stable code attached to err, or "" if err is nil,
+// carries no *ParseError in its chain, or that *ParseError has no Code
+// set (the common case for errors predating this catalog, or ones
+// deliberately left uncategorized).
+func ErrorCode(err error) string {
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		return ""
+	}
+	return pe.Code
+}
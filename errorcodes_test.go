@@ -0,0 +1,39 @@
+package cnab
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrorCodeExtractsCodeFromParseError(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	_, err := ParseRecord(spec, "12")
+	if err == nil {
+		t.Fatal("expected a parse error for a short line")
+	}
+	if code := ErrorCode(err); code != CodeFieldOutOfBounds {
+		t.Errorf("ErrorCode = %q, want %q", code, CodeFieldOutOfBounds)
+	}
+}
+
+func TestErrorCodeReturnsEmptyForNonParseError(t *testing.T) {
+	if code := ErrorCode(nil); code != "" {
+		t.Errorf("ErrorCode(nil) = %q, want \"\"", code)
+	}
+	if code := ErrorCode(errors.New("plain error")); code != "" {
+		t.Errorf("ErrorCode(non-ParseError) = %q, want \"\"", code)
+	}
+}
+
+func TestErrorCodeValidationFailure(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "id", Start: 0, Length: 4, Validators: []string{"required"}},
+	}}
+	_, err := ParseRecord(spec, "    ")
+	if err == nil {
+		t.Fatal("expected a validation error")
+	}
+	if code := ErrorCode(err); code != CodeValidationFailed {
+		t.Errorf("ErrorCode = %q, want %q", code, CodeValidationFailed)
+	}
+}
@@ -0,0 +1,29 @@
+package cnab
+
+import "fmt"
+
+// ParseError describes a failure while parsing a record, including enough
+// positional metadata to locate the offending bytes without re-running
+// the parse.
+type ParseError struct {
+	Field string
+	Msg   string
+	// Line is the 1-based line number within the file, or 0 if unknown
+	// (e.g. when parsing a single record outside of a file context).
+	Line int
+	// Start and End are the zero-based byte offsets of the field within
+	// its line.
+	Start int
+	End   int
+	// Code is a stable error code (see the Code* constants) identifying
+	// the kind of failure, for callers that want to match on something
+	// more durable than Error()'s message. "" means uncategorized.
+	Code string
+}
+
+func (e *ParseError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("cnab: line %d: parse field %q (bytes %d-%d): %s", e.Line, e.Field, e.Start, e.End, e.Msg)
+	}
+	return fmt.Sprintf("cnab: parse field %q (bytes %d-%d): %s", e.Field, e.Start, e.End, e.Msg)
+}
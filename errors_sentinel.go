@@ -0,0 +1,56 @@
+package cnab
+
+import "errors"
+
+// Sentinel errors matching a *ParseError's Code via ParseError.Is, so
+// callers can write errors.Is(err, cnab.ErrFieldOverflow) instead of
+// comparing ErrorCode(err) against a code string by hand.
+var (
+	ErrFieldOutOfBounds   = errors.New("cnab: field out of bounds")
+	ErrFieldOverflow      = errors.New("cnab: field overflow")
+	ErrValidationFailed   = errors.New("cnab: validation failed")
+	ErrInvalidBigInt      = errors.New("cnab: invalid bigint")
+	ErrStructuralMismatch = errors.New("cnab: structural mismatch")
+)
+
+var sentinelByCode = map[string]error{
+	CodeFieldOutOfBounds:   ErrFieldOutOfBounds,
+	CodeFieldOverflow:      ErrFieldOverflow,
+	CodeValidationFailed:   ErrValidationFailed,
+	CodeInvalidBigInt:      ErrInvalidBigInt,
+	CodeStructuralMismatch: ErrStructuralMismatch,
+}
+
+// Is reports whether e matches target, so errors.Is(err, ErrFieldOverflow)
+// and similar work directly against a *ParseError without a type switch
+// or reflection: true when target is the sentinel registered for e.Code.
+// An uncategorized ParseError (Code == "") matches nothing.
+func (e *ParseError) Is(target error) bool {
+	if e.Code == "" {
+		return false
+	}
+	return sentinelByCode[e.Code] == target
+}
+
+// IsFieldOutOfBounds reports whether err is, or wraps, a ParseError for a
+// field or group that extended beyond the end of its line. A thin
+// wrapper over errors.Is for callers migrating from ErrorCode-based
+// checks.
+func IsFieldOutOfBounds(err error) bool { return errors.Is(err, ErrFieldOutOfBounds) }
+
+// IsFieldOverflow reports whether err is, or wraps, a ParseError for a
+// value rejected for exceeding its field's Length.
+func IsFieldOverflow(err error) bool { return errors.Is(err, ErrFieldOverflow) }
+
+// IsValidationFailed reports whether err is, or wraps, a ParseError for a
+// value rejected by one of its field's Validators rules.
+func IsValidationFailed(err error) bool { return errors.Is(err, ErrValidationFailed) }
+
+// IsInvalidBigInt reports whether err is, or wraps, a ParseError for a
+// Type: "bigint" field holding something other than base-10 digits.
+func IsInvalidBigInt(err error) bool { return errors.Is(err, ErrInvalidBigInt) }
+
+// IsStructuralMismatch reports whether err is, or wraps, a ParseError for
+// a file-level structural problem (a trailer with no matching header, a
+// detail record outside any batch, and similar).
+func IsStructuralMismatch(err error) bool { return errors.Is(err, ErrStructuralMismatch) }
@@ -0,0 +1,43 @@
+package cnab
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseErrorIsMatchesSentinelByCode(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "id", Start: 0, Length: 4, Validators: []string{"required"}},
+	}}
+	_, err := ParseRecord(spec, "    ")
+	if err == nil {
+		t.Fatal("expected a validation error")
+	}
+	if !errors.Is(err, ErrValidationFailed) {
+		t.Error("expected errors.Is to match ErrValidationFailed")
+	}
+	if errors.Is(err, ErrFieldOverflow) {
+		t.Error("did not expect errors.Is to match an unrelated sentinel")
+	}
+	if !IsValidationFailed(err) {
+		t.Error("expected IsValidationFailed to report true")
+	}
+}
+
+func TestParseErrorIsUncategorizedMatchesNothing(t *testing.T) {
+	err := &ParseError{Field: "x", Msg: "uncategorized"}
+	if errors.Is(err, ErrValidationFailed) {
+		t.Error("expected an uncategorized ParseError to match no sentinel")
+	}
+}
+
+func TestIsFieldOutOfBoundsOnShortLine(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	_, err := ParseRecord(spec, "12")
+	if err == nil {
+		t.Fatal("expected a parse error")
+	}
+	if !IsFieldOutOfBounds(err) {
+		t.Error("expected IsFieldOutOfBounds to report true")
+	}
+}
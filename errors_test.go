@@ -0,0 +1,18 @@
+package cnab
+
+import "testing"
+
+func TestParseRecordAtLineMetadata(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "kind", Start: 0, Length: 2, Validators: []string{"in:01,02"}}}}
+	_, err := ParseRecordAt(spec, "99", 7)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	pe, ok := err.(*ParseError)
+	if !ok {
+		t.Fatalf("got %T, want *ParseError", err)
+	}
+	if pe.Line != 7 || pe.Start != 0 || pe.End != 2 {
+		t.Errorf("got Line=%d Start=%d End=%d, want Line=7 Start=0 End=2", pe.Line, pe.Start, pe.End)
+	}
+}
@@ -0,0 +1,164 @@
+package cnab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Safety limits for the embedded expression engine used to evaluate
+// derived/computed field values. These bound the cost of evaluating an
+// untrusted expression string embedded in a spec.
+const (
+	// MaxExprLength is the longest expression string EvalExpr accepts.
+	MaxExprLength = 256
+	// MaxExprDepth is the deepest nesting of parentheses EvalExpr allows.
+	MaxExprDepth = 32
+)
+
+// EvalExpr evaluates a minimal arithmetic expression (+, -, *, /,
+// parentheses, numeric literals and $name field references resolved
+// against vars) and returns the result formatted as a string.
+//
+// It exists to support simple derived/computed field values in specs
+// without pulling in a general-purpose scripting language, and enforces
+// MaxExprLength and MaxExprDepth so a malformed or hostile spec cannot
+// cause unbounded recursion or memory use.
+func EvalExpr(expression string, vars map[string]string) (string, error) {
+	if len(expression) > MaxExprLength {
+		return "", fmt.Errorf("cnab: expression exceeds max length %d", MaxExprLength)
+	}
+	p := &exprParser{input: expression, vars: vars}
+	v, err := p.parseExpr(0)
+	if err != nil {
+		return "", err
+	}
+	p.skipSpace()
+	if p.pos != len(p.input) {
+		return "", fmt.Errorf("cnab: unexpected input at offset %d in %q", p.pos, expression)
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64), nil
+}
+
+type exprParser struct {
+	input string
+	pos   int
+	vars  map[string]string
+}
+
+func (p *exprParser) skipSpace() {
+	for p.pos < len(p.input) && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *exprParser) parseExpr(depth int) (float64, error) {
+	if depth > MaxExprDepth {
+		return 0, fmt.Errorf("cnab: expression exceeds max nesting depth %d", MaxExprDepth)
+	}
+	left, err := p.parseTerm(depth)
+	if err != nil {
+		return 0, err
+	}
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.input) {
+			return left, nil
+		}
+		op := p.input[p.pos]
+		if op != '+' && op != '-' {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseTerm(depth)
+		if err != nil {
+			return 0, err
+		}
+		if op == '+' {
+			left += right
+		} else {
+			left -= right
+		}
+	}
+}
+
+func (p *exprParser) parseTerm(depth int) (float64, error) {
+	left, err := p.parseFactor(depth)
+	if err != nil {
+		return 0, err
+	}
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.input) {
+			return left, nil
+		}
+		op := p.input[p.pos]
+		if op != '*' && op != '/' {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseFactor(depth)
+		if err != nil {
+			return 0, err
+		}
+		if op == '*' {
+			left *= right
+		} else {
+			if right == 0 {
+				return 0, fmt.Errorf("cnab: division by zero")
+			}
+			left /= right
+		}
+	}
+}
+
+func (p *exprParser) parseFactor(depth int) (float64, error) {
+	p.skipSpace()
+	if p.pos >= len(p.input) {
+		return 0, fmt.Errorf("cnab: unexpected end of expression")
+	}
+	if p.input[p.pos] == '(' {
+		p.pos++
+		v, err := p.parseExpr(depth + 1)
+		if err != nil {
+			return 0, err
+		}
+		p.skipSpace()
+		if p.pos >= len(p.input) || p.input[p.pos] != ')' {
+			return 0, fmt.Errorf("cnab: missing closing parenthesis")
+		}
+		p.pos++
+		return v, nil
+	}
+	if p.input[p.pos] == '$' {
+		start := p.pos + 1
+		end := start
+		for end < len(p.input) && (isAlnum(p.input[end]) || p.input[end] == '_') {
+			end++
+		}
+		name := p.input[start:end]
+		p.pos = end
+		val, ok := p.vars[name]
+		if !ok {
+			return 0, fmt.Errorf("cnab: unknown field reference $%s", name)
+		}
+		n, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+		if err != nil {
+			return 0, fmt.Errorf("cnab: field $%s is not numeric: %q", name, val)
+		}
+		return n, nil
+	}
+	start := p.pos
+	for p.pos < len(p.input) && (isDigit(p.input[p.pos]) || p.input[p.pos] == '.') {
+		p.pos++
+	}
+	if start == p.pos {
+		return 0, fmt.Errorf("cnab: unexpected character %q at offset %d", p.input[p.pos], p.pos)
+	}
+	return strconv.ParseFloat(p.input[start:p.pos], 64)
+}
+
+func isDigit(b byte) bool { return b >= '0' && b <= '9' }
+func isAlnum(b byte) bool {
+	return isDigit(b) || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
@@ -0,0 +1,36 @@
+package cnab
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEvalExpr(t *testing.T) {
+	v, err := EvalExpr("($price + 1) * $qty", map[string]string{"price": "9", "qty": "2"})
+	if err != nil {
+		t.Fatalf("EvalExpr: %v", err)
+	}
+	if v != "20" {
+		t.Errorf("got %q, want 20", v)
+	}
+}
+
+func TestEvalExprMaxLength(t *testing.T) {
+	expr := strings.Repeat("1+", MaxExprLength)
+	if _, err := EvalExpr(expr, nil); err == nil {
+		t.Fatal("expected error for over-length expression")
+	}
+}
+
+func TestEvalExprMaxDepth(t *testing.T) {
+	expr := strings.Repeat("(", MaxExprDepth+1) + "1" + strings.Repeat(")", MaxExprDepth+1)
+	if _, err := EvalExpr(expr, nil); err == nil {
+		t.Fatal("expected error for over-nested expression")
+	}
+}
+
+func TestEvalExprDivisionByZero(t *testing.T) {
+	if _, err := EvalExpr("1/0", nil); err == nil {
+		t.Fatal("expected division by zero error")
+	}
+}
@@ -0,0 +1,66 @@
+package cnab
+
+// FieldHandler customizes how a single field is parsed and formatted,
+// for the rare field a Validators/PreParse/PostFormat rule chain can't
+// express cleanly (e.g. a bespoke binary-packed-decimal encoding).
+// Either func may be left nil to fall back to the field's normal
+// PreParse/PostFormat handling.
+type FieldHandler struct {
+	Parse  func(raw string) (string, error)
+	Format func(value string) (string, error)
+}
+
+// FieldHandlerStore holds FieldHandler overrides keyed by field name, plus
+// type-level defaults keyed by FieldSpec.Type, for use with Processor's
+// WithFieldHandlerStore option. The zero value is not usable; construct
+// one with NewFieldHandlerStore.
+type FieldHandlerStore struct {
+	handlers map[string]FieldHandler
+	byType   map[string]FieldHandler
+}
+
+// NewFieldHandlerStore returns an empty FieldHandlerStore.
+func NewFieldHandlerStore() *FieldHandlerStore {
+	return &FieldHandlerStore{handlers: make(map[string]FieldHandler)}
+}
+
+// Register installs h as the handler for fieldName, replacing any
+// existing handler for that name. A field-name handler always takes
+// priority over a type-level default registered via RegisterType.
+func (s *FieldHandlerStore) Register(fieldName string, h FieldHandler) {
+	s.handlers[fieldName] = h
+}
+
+// RegisterType installs h as the default handler for every field whose
+// FieldSpec.Type equals fieldType (e.g. "bigint"), used by Lookup when no
+// field-name override is registered. This lets a whole class of fields
+// share one handler while still allowing a single odd field (e.g. a date
+// stored as DDMMYYYY in one bank's layout) to be overridden by name
+// without defining a new FieldSpec.Type just for it.
+func (s *FieldHandlerStore) RegisterType(fieldType string, h FieldHandler) {
+	if s.byType == nil {
+		s.byType = make(map[string]FieldHandler)
+	}
+	s.byType[fieldType] = h
+}
+
+// Lookup returns the handler to use for a field named fieldName with
+// FieldSpec.Type fieldType: a field-name override registered via Register
+// takes priority, falling back to a type-level default registered via
+// RegisterType, then ok=false if neither applies. Lookup on a nil
+// *FieldHandlerStore returns ok=false, so a Processor without
+// WithFieldHandlerStore can call it unconditionally.
+func (s *FieldHandlerStore) Lookup(fieldName, fieldType string) (FieldHandler, bool) {
+	if s == nil {
+		return FieldHandler{}, false
+	}
+	if h, ok := s.handlers[fieldName]; ok {
+		return h, true
+	}
+	if fieldType != "" {
+		if h, ok := s.byType[fieldType]; ok {
+			return h, true
+		}
+	}
+	return FieldHandler{}, false
+}
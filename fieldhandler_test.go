@@ -0,0 +1,38 @@
+package cnab
+
+import "testing"
+
+func TestFieldHandlerStoreLookupPrefersFieldNameOverType(t *testing.T) {
+	s := NewFieldHandlerStore()
+	byType := FieldHandler{Parse: func(raw string) (string, error) { return "from-type", nil }}
+	byName := FieldHandler{Parse: func(raw string) (string, error) { return "from-name", nil }}
+	s.RegisterType("bigint", byType)
+	s.Register("nosso_numero", byName)
+
+	h, ok := s.Lookup("nosso_numero", "bigint")
+	if !ok {
+		t.Fatal("expected a handler to be found")
+	}
+	v, _ := h.Parse("123")
+	if v != "from-name" {
+		t.Errorf("Parse = %q, want from-name (field-name override should win)", v)
+	}
+}
+
+func TestFieldHandlerStoreLookupFallsBackToType(t *testing.T) {
+	s := NewFieldHandlerStore()
+	s.RegisterType("bigint", FieldHandler{Parse: func(raw string) (string, error) { return "from-type", nil }})
+
+	h, ok := s.Lookup("some_other_field", "bigint")
+	if !ok {
+		t.Fatal("expected the type-level default to be found")
+	}
+	v, _ := h.Parse("123")
+	if v != "from-type" {
+		t.Errorf("Parse = %q, want from-type", v)
+	}
+
+	if _, ok := s.Lookup("some_other_field", ""); ok {
+		t.Error("expected no handler for an empty field type with no field-name override")
+	}
+}
@@ -0,0 +1,29 @@
+package cnab
+
+// FileSpec describes the structural layout of a complete CNAB file: its
+// header and trailer records, the detail record layout, and the field
+// names used to cross-check structural invariants.
+type FileSpec struct {
+	Header  RecordSpec
+	Detail  RecordSpec
+	Trailer RecordSpec
+
+	// SeqField, if set, names the Detail field holding a sequential
+	// record number starting at 1.
+	SeqField string
+	// AmountField, if set, names the Detail field summed for
+	// TrailerSumField comparison.
+	AmountField string
+	// TrailerCountField, if set, names the Trailer field holding the
+	// total number of detail records.
+	TrailerCountField string
+	// TrailerSumField, if set, names the Trailer field holding the sum
+	// of AmountField across all detail records.
+	TrailerSumField string
+	// DuplicateKeyFields, if set, names the Detail fields whose combined
+	// value must be unique across all detail records in a remessa (e.g.
+	// nosso número, or document number + due date) — sending the same
+	// título twice is a common integration bug that otherwise only
+	// surfaces as a confusing rejection from the bank days later.
+	DuplicateKeyFields []string
+}
@@ -0,0 +1,118 @@
+package cnab
+
+import (
+	"bufio"
+	"context"
+	"io"
+)
+
+// CNAB240 tipo_registro values (byte offset 7 of every line, the same
+// position in the file header, every lote header/trailer, and every
+// detail segment) used by ParseFileTree to tell record kinds apart.
+const (
+	cnab240RecordTypeFileHeader   = '0'
+	cnab240RecordTypeBatchHeader  = '1'
+	cnab240RecordTypeBatchTrailer = '5'
+	cnab240RecordTypeFileTrailer  = '9'
+)
+
+// FileTreeSpec names the specs ParseFileTree needs to reconstruct a
+// CNAB240 file's hierarchy from its flat lines: the file-level header
+// and trailer, and the layout shared by every lote's header, detail
+// records, and trailer.
+type FileTreeSpec struct {
+	FileHeader  RecordSpec
+	FileTrailer RecordSpec
+	Batch       BatchSpec
+}
+
+// ParsedBatch is one lote reconstructed by ParseFileTree. Its Details
+// are kept in file order but not further split by segment; group
+// consecutive segments (P+Q+R and similar) belonging to the same título
+// with a segment group assembler on top of this.
+type ParsedBatch struct {
+	Header  *Record
+	Details []*Record
+	Trailer *Record
+}
+
+// FileTree is the hierarchical structure ParseFileTree reconstructs
+// from a flat CNAB240 file.
+type FileTree struct {
+	Header  *Record
+	Batches []ParsedBatch
+	Trailer *Record
+}
+
+// ParseFileTree reads r line by line and reconstructs a CNAB240 file's
+// hierarchical structure — file header, lotes (each with its own header,
+// details and trailer), file trailer — using spec, instead of returning
+// a flat stream of records and leaving transaction-level grouping to the
+// caller.
+func ParseFileTree(ctx context.Context, spec FileTreeSpec, r io.Reader) (*FileTree, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	tree := &FileTree{}
+	var current *ParsedBatch
+	lineNum := 0
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return tree, err
+		}
+		lineNum++
+		line := scanner.Text()
+		if len(line) < 8 {
+			return nil, &ParseError{Msg: "line shorter than the tipo_registro offset", Line: lineNum}
+		}
+
+		switch line[7] {
+		case cnab240RecordTypeFileHeader:
+			rec, err := ParseRecordAt(spec.FileHeader, line, lineNum)
+			if err != nil {
+				return nil, err
+			}
+			tree.Header = rec
+
+		case cnab240RecordTypeBatchHeader:
+			rec, err := ParseRecordAt(spec.Batch.Header, line, lineNum)
+			if err != nil {
+				return nil, err
+			}
+			current = &ParsedBatch{Header: rec}
+
+		case cnab240RecordTypeBatchTrailer:
+			rec, err := ParseRecordAt(spec.Batch.Trailer, line, lineNum)
+			if err != nil {
+				return nil, err
+			}
+			if current == nil {
+				return nil, &ParseError{Msg: "lote trailer without a matching lote header", Line: lineNum, Code: CodeStructuralMismatch}
+			}
+			current.Trailer = rec
+			tree.Batches = append(tree.Batches, *current)
+			current = nil
+
+		case cnab240RecordTypeFileTrailer:
+			rec, err := ParseRecordAt(spec.FileTrailer, line, lineNum)
+			if err != nil {
+				return nil, err
+			}
+			tree.Trailer = rec
+
+		default:
+			rec, err := ParseRecordAt(spec.Batch.Detail, line, lineNum)
+			if err != nil {
+				return nil, err
+			}
+			if current == nil {
+				return nil, &ParseError{Msg: "detail record outside any lote", Line: lineNum, Code: CodeStructuralMismatch}
+			}
+			current.Details = append(current.Details, rec)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return tree, err
+	}
+	return tree, nil
+}
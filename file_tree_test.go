@@ -0,0 +1,73 @@
+package cnab
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func recordTypeSpec(recordType byte) RecordSpec {
+	return RecordSpec{Fields: []FieldSpec{
+		{Name: "tipo_registro", Start: 7, Length: 1, Validators: []string{"in:" + string(recordType)}},
+		{Name: "id", Start: 8, Length: 2},
+	}}
+}
+
+func TestParseFileTreeReconstructsHierarchy(t *testing.T) {
+	spec := FileTreeSpec{
+		FileHeader:  recordTypeSpec('0'),
+		FileTrailer: recordTypeSpec('9'),
+		Batch: BatchSpec{
+			Header:  recordTypeSpec('1'),
+			Detail:  recordTypeSpec('3'),
+			Trailer: recordTypeSpec('5'),
+		},
+	}
+
+	lines := []string{
+		"0000000" + "0" + "01",
+		"0000000" + "1" + "01",
+		"0000000" + "3" + "01",
+		"0000000" + "3" + "02",
+		"0000000" + "5" + "01",
+		"0000000" + "1" + "02",
+		"0000000" + "3" + "03",
+		"0000000" + "5" + "02",
+		"0000000" + "9" + "01",
+	}
+	tree, err := ParseFileTree(context.Background(), spec, strings.NewReader(strings.Join(lines, "\n")))
+	if err != nil {
+		t.Fatalf("ParseFileTree: %v", err)
+	}
+	if tree.Header == nil || tree.Trailer == nil {
+		t.Fatal("expected file header and trailer to be populated")
+	}
+	if len(tree.Batches) != 2 {
+		t.Fatalf("got %d batches, want 2", len(tree.Batches))
+	}
+	if len(tree.Batches[0].Details) != 2 {
+		t.Errorf("batch 0: got %d details, want 2", len(tree.Batches[0].Details))
+	}
+	if len(tree.Batches[1].Details) != 1 {
+		t.Errorf("batch 1: got %d details, want 1", len(tree.Batches[1].Details))
+	}
+	if tree.Batches[0].Trailer == nil || tree.Batches[1].Trailer == nil {
+		t.Error("expected every batch to have its trailer populated")
+	}
+}
+
+func TestParseFileTreeRejectsDetailOutsideBatch(t *testing.T) {
+	spec := FileTreeSpec{
+		FileHeader:  recordTypeSpec('0'),
+		FileTrailer: recordTypeSpec('9'),
+		Batch: BatchSpec{
+			Header:  recordTypeSpec('1'),
+			Detail:  recordTypeSpec('3'),
+			Trailer: recordTypeSpec('5'),
+		},
+	}
+	lines := []string{"0000000" + "0" + "01", "0000000" + "3" + "01"}
+	if _, err := ParseFileTree(context.Background(), spec, strings.NewReader(strings.Join(lines, "\n"))); err == nil {
+		t.Fatal("expected an error for a detail record outside any lote")
+	}
+}
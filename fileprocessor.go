@@ -0,0 +1,134 @@
+package cnab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FileProcessor validates structural invariants of a complete CNAB file
+// against a FileSpec.
+type FileProcessor struct {
+	Spec FileSpec
+}
+
+// NewFileProcessor creates a FileProcessor for spec.
+func NewFileProcessor(spec FileSpec) *FileProcessor {
+	return &FileProcessor{Spec: spec}
+}
+
+// ValidationReport collects all structural violations found while
+// validating a file, rather than failing on the first one.
+type ValidationReport struct {
+	Violations []string
+}
+
+// Valid reports whether no violations were recorded.
+func (r *ValidationReport) Valid() bool {
+	return len(r.Violations) == 0
+}
+
+func (r *ValidationReport) addf(format string, args ...interface{}) {
+	r.Violations = append(r.Violations, fmt.Sprintf(format, args...))
+}
+
+// Validate checks lines (one per record, header first and trailer last)
+// against p.Spec, returning a report of every violation found.
+func (p *FileProcessor) Validate(lines []string) (*ValidationReport, error) {
+	report := &ValidationReport{}
+
+	if len(lines) < 2 {
+		report.addf("file must contain at least a header and a trailer record")
+		return report, nil
+	}
+
+	if _, err := ParseRecord(p.Spec.Header, lines[0]); err != nil {
+		report.addf("header: %v", err)
+	}
+
+	trailerLine := lines[len(lines)-1]
+	trailer, err := ParseRecord(p.Spec.Trailer, trailerLine)
+	if err != nil {
+		report.addf("trailer: %v", err)
+	}
+
+	detailLines := lines[1 : len(lines)-1]
+	details := make([]*Record, 0, len(detailLines))
+	for i, line := range detailLines {
+		rec, err := ParseRecord(p.Spec.Detail, line)
+		if err != nil {
+			report.addf("detail record %d: %v", i+1, err)
+			continue
+		}
+		details = append(details, rec)
+	}
+
+	if len(p.Spec.DuplicateKeyFields) > 0 {
+		seen := make(map[string]int, len(details))
+		for i, rec := range details {
+			key := detailDuplicateKey(rec, p.Spec.DuplicateKeyFields)
+			if first, ok := seen[key]; ok {
+				report.addf("detail record %d: duplicate of detail record %d (key %q)", i+1, first+1, key)
+				continue
+			}
+			seen[key] = i
+		}
+	}
+
+	if p.Spec.SeqField != "" {
+		for i, rec := range details {
+			want := strconv.Itoa(i + 1)
+			if got, _ := rec.Get(p.Spec.SeqField); got != "" {
+				if n, err := strconv.Atoi(trimLeadingZeros(got)); err != nil || n != i+1 {
+					report.addf("detail record %d: sequence number %q, want %s", i+1, got, want)
+				}
+			}
+		}
+	}
+
+	if trailer != nil && p.Spec.TrailerCountField != "" {
+		wantCount, _ := trailer.Get(p.Spec.TrailerCountField)
+		if n, err := strconv.Atoi(trimLeadingZeros(wantCount)); err != nil || n != len(details) {
+			report.addf("trailer record count %q does not match %d actual detail records", wantCount, len(details))
+		}
+	}
+
+	if trailer != nil && p.Spec.AmountField != "" && p.Spec.TrailerSumField != "" {
+		sum := 0
+		for _, rec := range details {
+			v, _ := rec.Get(p.Spec.AmountField)
+			n, err := strconv.Atoi(trimLeadingZeros(v))
+			if err != nil {
+				report.addf("detail amount %q is not numeric", v)
+				continue
+			}
+			sum += n
+		}
+		wantSum, _ := trailer.Get(p.Spec.TrailerSumField)
+		if n, err := strconv.Atoi(trimLeadingZeros(wantSum)); err != nil || n != sum {
+			report.addf("trailer total %q does not match computed sum %d", wantSum, sum)
+		}
+	}
+
+	return report, nil
+}
+
+// detailDuplicateKey builds the composite key used to detect duplicate
+// detail records, joining each named field's value with a separator that
+// cannot appear in a fixed-width numeric/alphanumeric field.
+func detailDuplicateKey(rec *Record, fields []string) string {
+	parts := make([]string, len(fields))
+	for i, name := range fields {
+		v, _ := rec.Get(name)
+		parts[i] = v
+	}
+	return strings.Join(parts, "\x1f")
+}
+
+func trimLeadingZeros(s string) string {
+	i := 0
+	for i < len(s)-1 && s[i] == '0' {
+		i++
+	}
+	return s[i:]
+}
@@ -0,0 +1,73 @@
+package cnab
+
+import "testing"
+
+func TestFileProcessorValidate(t *testing.T) {
+	spec := FileSpec{
+		Header:  RecordSpec{Fields: []FieldSpec{{Name: "type", Start: 0, Length: 1}}},
+		Trailer: RecordSpec{Fields: []FieldSpec{{Name: "count", Start: 0, Length: 3}, {Name: "sum", Start: 3, Length: 6}}},
+		Detail: RecordSpec{Fields: []FieldSpec{
+			{Name: "seq", Start: 0, Length: 2},
+			{Name: "amount", Start: 2, Length: 4},
+		}},
+		SeqField:          "seq",
+		AmountField:       "amount",
+		TrailerCountField: "count",
+		TrailerSumField:   "sum",
+	}
+	p := NewFileProcessor(spec)
+
+	lines := []string{
+		"H",
+		"010010",
+		"020020",
+		"002000030",
+	}
+	report, err := p.Validate(lines)
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if !report.Valid() {
+		t.Fatalf("expected valid file, got violations: %v", report.Violations)
+	}
+
+	badLines := []string{"H", "010010", "020020", "005000099"}
+	report, err = p.Validate(badLines)
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if report.Valid() {
+		t.Fatal("expected violations for mismatched trailer totals")
+	}
+}
+
+func TestFileProcessorValidateFlagsDuplicateDetailKeys(t *testing.T) {
+	spec := FileSpec{
+		Header:  RecordSpec{Fields: []FieldSpec{{Name: "type", Start: 0, Length: 1}}},
+		Trailer: RecordSpec{Fields: []FieldSpec{{Name: "count", Start: 0, Length: 3}}},
+		Detail: RecordSpec{Fields: []FieldSpec{
+			{Name: "nosso_numero", Start: 0, Length: 6},
+		}},
+		TrailerCountField:  "count",
+		DuplicateKeyFields: []string{"nosso_numero"},
+	}
+	p := NewFileProcessor(spec)
+
+	lines := []string{"H", "000001", "000002", "000001", "003"}
+	report, err := p.Validate(lines)
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if report.Valid() {
+		t.Fatal("expected a violation for the duplicate nosso_numero")
+	}
+
+	okLines := []string{"H", "000001", "000002", "000003", "003"}
+	report, err = p.Validate(okLines)
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if !report.Valid() {
+		t.Fatalf("expected no violations for distinct keys, got: %v", report.Violations)
+	}
+}
@@ -0,0 +1,29 @@
+package cnab
+
+import "testing"
+
+// FuzzParseRecord exercises ParseRecord with arbitrary line content to
+// catch panics and parse/pack asymmetries (e.g. the float truncation and
+// padding issues that motivated cnabtest's round-trip helpers).
+func FuzzParseRecord(f *testing.F) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "id", Start: 0, Length: 6},
+		{Name: "name", Start: 6, Length: 10},
+	}}
+	f.Add("000001JOHN DOE   ")
+	f.Add("")
+	f.Fuzz(func(t *testing.T, line string) {
+		rec, err := ParseRecord(spec, line)
+		if err != nil {
+			return
+		}
+		values := make(map[string]string, len(spec.Fields))
+		for _, fld := range spec.Fields {
+			v, _ := rec.Get(fld.Name)
+			values[fld.Name] = v
+		}
+		if _, err := PackRecord(spec, values); err != nil {
+			t.Fatalf("PackRecord failed after successful ParseRecord: %v", err)
+		}
+	})
+}
@@ -0,0 +1,30 @@
+package cnab
+
+// GroupSpec describes a repeating group (an "occurs" clause) embedded
+// within a record: a fixed number of consecutive, equally-sized chunks of
+// the line, each parsed as its own mini-record using Fields, whose
+// Start/Length are relative to the start of one occurrence.
+type GroupSpec struct {
+	// Name identifies the group within the record.
+	Name string
+	// Start is the zero-based byte offset of the first occurrence.
+	Start int
+	// ItemLength is the width in bytes of a single occurrence.
+	ItemLength int
+	// Occurs is the number of repetitions.
+	Occurs int
+	// Fields describes one occurrence, with Start/Length relative to
+	// the occurrence's own start rather than the record's.
+	Fields []FieldSpec
+}
+
+// End returns the exclusive end offset of the whole group (Start plus all
+// occurrences).
+func (g GroupSpec) End() int {
+	return g.Start + g.ItemLength*g.Occurs
+}
+
+// itemSpec returns the RecordSpec used to parse/pack a single occurrence.
+func (g GroupSpec) itemSpec() RecordSpec {
+	return RecordSpec{Type: g.Name, Fields: g.Fields}
+}
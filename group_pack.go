@@ -0,0 +1,48 @@
+package cnab
+
+// PackRecordWithGroups behaves like PackRecord, but additionally packs
+// each occurrence of spec's repeating groups from groupValues, keyed by
+// group name, with one map per occurrence. Missing occurrences are left
+// blank (space-filled); extra entries beyond a group's Occurs are
+// ignored.
+func PackRecordWithGroups(spec RecordSpec, values map[string]string, groupValues map[string][]map[string]string) (string, error) {
+	width := 0
+	for _, f := range spec.Fields {
+		if f.End() > width {
+			width = f.End()
+		}
+	}
+	for _, g := range spec.Groups {
+		if g.End() > width {
+			width = g.End()
+		}
+	}
+
+	line, err := PackRecord(RecordSpec{Fields: spec.Fields}, values)
+	if err != nil {
+		return "", err
+	}
+	buf := []byte(line)
+	for len(buf) < width {
+		buf = append(buf, ' ')
+	}
+
+	for _, g := range spec.Groups {
+		itemSpec := g.itemSpec()
+		occurrences := groupValues[g.Name]
+		for i := 0; i < g.Occurs; i++ {
+			var occValues map[string]string
+			if i < len(occurrences) {
+				occValues = occurrences[i]
+			}
+			item, err := PackRecord(itemSpec, occValues)
+			if err != nil {
+				return "", err
+			}
+			itemStart := g.Start + i*g.ItemLength
+			copy(buf[itemStart:itemStart+g.ItemLength], item)
+		}
+	}
+
+	return string(buf), nil
+}
@@ -0,0 +1,53 @@
+package cnab
+
+import "testing"
+
+func groupSpec() RecordSpec {
+	return RecordSpec{
+		Fields: []FieldSpec{{Name: "count", Start: 0, Length: 1}},
+		Groups: []GroupSpec{{
+			Name:       "items",
+			Start:      1,
+			ItemLength: 4,
+			Occurs:     2,
+			Fields: []FieldSpec{
+				{Name: "sku", Start: 0, Length: 2},
+				{Name: "qty", Start: 2, Length: 2},
+			},
+		}},
+	}
+}
+
+func TestParseRecordWithGroups(t *testing.T) {
+	spec := groupSpec()
+	rec, err := ParseRecord(spec, "2A101B202")
+	if err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+	occs, ok := rec.Group("items")
+	if !ok || len(occs) != 2 {
+		t.Fatalf("got %v, %v", occs, ok)
+	}
+	if sku, _ := occs[0].Get("sku"); sku != "A1" {
+		t.Errorf("occ0 sku = %q, want A1", sku)
+	}
+	if qty, _ := occs[1].Get("qty"); qty != "02" {
+		t.Errorf("occ1 qty = %q, want 02", qty)
+	}
+}
+
+func TestPackRecordWithGroups(t *testing.T) {
+	spec := groupSpec()
+	line, err := PackRecordWithGroups(spec, map[string]string{"count": "2"}, map[string][]map[string]string{
+		"items": {
+			{"sku": "A1", "qty": "01"},
+			{"sku": "B2", "qty": "02"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("PackRecordWithGroups: %v", err)
+	}
+	if line != "2A101B202" {
+		t.Errorf("got %q, want 2A101B202", line)
+	}
+}
@@ -0,0 +1,15 @@
+// Package grpcserver will host the gRPC binding for the parse/pack/
+// validate service described in cnab.proto, generated with:
+//
+//	protoc --go_out=. --go-grpc_out=. cnab.proto
+//
+// The generated CnabServiceServer's Parse/Pack/Validate methods should
+// delegate to a *server.Server (see the sibling server package), which
+// already implements the same three operations over HTTP against a
+// cnab.Registry.
+//
+// This is a separate module (its own go.mod) so the core
+// github.com/RafOSS-br/cnab-stream module and the REST server package
+// stay free of the gRPC and protobuf dependencies for callers who only
+// need one transport.
+package grpcserver
@@ -0,0 +1,211 @@
+// Package ingest streams a CNAB file from any io.Reader (or
+// io.ReaderAt, via FromReaderAt) — including an S3 or GCS object
+// download — in bounded-size batches instead of materializing the whole
+// file, so multi-GB files can be processed with fixed memory.
+package ingest
+
+import (
+	"bufio"
+	"context"
+	"io"
+
+	"github.com/RafOSS-br/cnab-stream"
+)
+
+// Batch is one bounded-size group of consecutively parsed records.
+type Batch struct {
+	Records   []*cnab.Record
+	StartLine int
+}
+
+// ProgressFunc is called after each line that parses successfully (a line
+// passed to a RejectFunc does not trigger it), reporting cumulative bytes
+// read, total bytes (-1 if unknown), and records parsed so far.
+type ProgressFunc func(bytesRead, totalBytes int64, records int64)
+
+// FromReaderAt adapts an io.ReaderAt of known size (as returned by most
+// object-store SDKs' download APIs) into the io.Reader Stream expects.
+func FromReaderAt(r io.ReaderAt, size int64) io.Reader {
+	return io.NewSectionReader(r, 0, size)
+}
+
+// countingReader wraps r, tracking the cumulative number of bytes read
+// through it.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// Option configures optional Stream/StreamWithRejects behavior.
+type Option func(*streamConfig)
+
+type streamConfig struct {
+	yieldEvery      int
+	summary         *Summary
+	wantLength      int
+	lengthTolerance int
+	onProgress      ProgressFunc
+}
+
+// WithYieldEvery sets how many lines Stream/StreamWithRejects processes
+// between context-cancellation checks (default 1, i.e. every line).
+// Checking ctx.Err() is cheap, but on a very high line-rate stream with a
+// context that is rarely canceled, checking every n lines instead of
+// every line trims that per-line overhead; n <= 1 checks every line.
+func WithYieldEvery(n int) Option {
+	return func(c *streamConfig) { c.yieldEvery = n }
+}
+
+// WithSummary has Stream/StreamWithRejects fill s with the defects
+// (stripped BOM, mixed line terminators, out-of-tolerance line lengths)
+// observed over the run, available once the call returns. s must not be
+// nil.
+func WithSummary(s *Summary) Option {
+	return func(c *streamConfig) { c.summary = s }
+}
+
+// WithProgress sets the ProgressFunc Stream/StreamWithRejects reports
+// progress to, as an alternative to passing onProgress positionally —
+// useful when the rest of the call is built up via Option values (e.g.
+// alongside WithSummary) and threading one more positional nil through
+// every call site would be noise. If both are given, the positional
+// onProgress argument wins.
+func WithProgress(fn ProgressFunc) Option {
+	return func(c *streamConfig) { c.onProgress = fn }
+}
+
+// WithLengthTolerance has Stream/StreamWithRejects record a
+// LengthAnomaly (in the Summary passed to WithSummary) for any line
+// whose length differs from want by more than tolerance bytes. It does
+// not reject or alter the line; ParseRecord already rejects a line too
+// short for the spec on its own. Use this to flag a line that's merely
+// suspicious, e.g. one extra trailing byte left behind by a bad
+// transfer.
+func WithLengthTolerance(want, tolerance int) Option {
+	return func(c *streamConfig) {
+		c.wantLength = want
+		c.lengthTolerance = tolerance
+	}
+}
+
+// Stream reads r line by line, parses each line against spec, and calls
+// onBatch once every batchSize records (and once more for a final
+// partial batch), so at most batchSize parsed records are held in memory
+// at a time. It stops and returns ctx.Err() if ctx is done between
+// lines (see WithYieldEvery to control how often that check happens), or
+// the first parse error encountered — use StreamWithRejects to divert
+// unparseable lines instead of aborting. If r is also an io.Seeker,
+// Stream reports its total size to onProgress; otherwise totalBytes is
+// reported as -1.
+func Stream(ctx context.Context, r io.Reader, spec cnab.RecordSpec, batchSize int, onBatch func(Batch) error, onProgress ProgressFunc, opts ...Option) error {
+	return stream(ctx, r, spec, batchSize, onBatch, onProgress, nil, opts)
+}
+
+// RejectFunc is called for a line that failed to parse, with its 1-based
+// line number, raw content, and the resulting error.
+type RejectFunc func(lineNumber int, line string, err error)
+
+// StreamWithRejects behaves like Stream, except a line that fails to
+// parse is passed to onReject and skipped instead of aborting the whole
+// stream, so processing continues past isolated bad records.
+func StreamWithRejects(ctx context.Context, r io.Reader, spec cnab.RecordSpec, batchSize int, onBatch func(Batch) error, onProgress ProgressFunc, onReject RejectFunc, opts ...Option) error {
+	return stream(ctx, r, spec, batchSize, onBatch, onProgress, onReject, opts)
+}
+
+func stream(ctx context.Context, r io.Reader, spec cnab.RecordSpec, batchSize int, onBatch func(Batch) error, onProgress ProgressFunc, onReject RejectFunc, opts []Option) error {
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	cfg := streamConfig{yieldEvery: 1}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.yieldEvery < 1 {
+		cfg.yieldEvery = 1
+	}
+	if onProgress == nil {
+		onProgress = cfg.onProgress
+	}
+
+	total := int64(-1)
+	if seeker, ok := r.(io.Seeker); ok {
+		if cur, err := seeker.Seek(0, io.SeekCurrent); err == nil {
+			if end, err := seeker.Seek(0, io.SeekEnd); err == nil {
+				total = end
+				_, _ = seeker.Seek(cur, io.SeekStart)
+			}
+		}
+	}
+
+	counting := &countingReader{r: r}
+	sanitized, bomStripped := stripBOM(counting)
+	if cfg.summary != nil {
+		cfg.summary.BOMStripped = bomStripped
+	}
+	scanner := bufio.NewScanner(sanitized)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	if cfg.summary != nil {
+		scanner.Split(splitLinesTrackingTerminators(cfg.summary))
+	}
+
+	var batch []*cnab.Record
+	var lineNum int
+	var recordsTotal int64
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := onBatch(Batch{Records: batch, StartLine: lineNum - len(batch) + 1})
+		batch = nil
+		return err
+	}
+
+	for scanner.Scan() {
+		if lineNum%cfg.yieldEvery == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		lineNum++
+		line := scanner.Text()
+		if cfg.wantLength > 0 {
+			if diff := len(line) - cfg.wantLength; diff > cfg.lengthTolerance || -diff > cfg.lengthTolerance {
+				if cfg.summary != nil {
+					cfg.summary.LengthAnomalies = append(cfg.summary.LengthAnomalies, LengthAnomaly{Line: lineNum, Length: len(line)})
+				}
+			}
+		}
+		rec, err := cnab.ParseRecordAt(spec, line, lineNum)
+		if err != nil {
+			if onReject == nil {
+				return err
+			}
+			onReject(lineNum, line, err)
+			continue
+		}
+		batch = append(batch, rec)
+		recordsTotal++
+		if onProgress != nil {
+			onProgress(counting.n, total, recordsTotal)
+		}
+		if len(batch) >= batchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if cfg.summary != nil {
+		cfg.summary.MixedTerminators = cfg.summary.CRLFCount > 0 && cfg.summary.LFCount > 0
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return flush()
+}
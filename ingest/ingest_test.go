@@ -0,0 +1,75 @@
+package ingest
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/RafOSS-br/cnab-stream"
+)
+
+func TestStreamBatchesRecords(t *testing.T) {
+	spec := cnab.RecordSpec{Fields: []cnab.FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	data := strings.Join([]string{"0001", "0002", "0003", "0004", "0005"}, "\n")
+
+	var batches []Batch
+	err := Stream(context.Background(), strings.NewReader(data), spec, 2, func(b Batch) error {
+		batches = append(batches, b)
+		return nil
+	}, nil)
+	if err != nil {
+		t.Fatalf("Stream: %v", err)
+	}
+
+	if len(batches) != 3 {
+		t.Fatalf("got %d batches, want 3 (2+2+1)", len(batches))
+	}
+	if len(batches[0].Records) != 2 || len(batches[2].Records) != 1 {
+		t.Errorf("batch sizes = %d,%d,%d, want 2,2,1", len(batches[0].Records), len(batches[1].Records), len(batches[2].Records))
+	}
+	if batches[2].StartLine != 5 {
+		t.Errorf("last batch StartLine = %d, want 5", batches[2].StartLine)
+	}
+}
+
+func TestStreamReportsProgressAndTotalSize(t *testing.T) {
+	spec := cnab.RecordSpec{Fields: []cnab.FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	data := "0001\n0002\n"
+	r := bytes.NewReader([]byte(data))
+
+	var lastTotal int64 = -2
+	var lastRecords int64
+	err := Stream(context.Background(), r, spec, 10, func(Batch) error { return nil }, func(bytesRead, totalBytes, records int64) {
+		lastTotal = totalBytes
+		lastRecords = records
+	})
+	if err != nil {
+		t.Fatalf("Stream: %v", err)
+	}
+	if lastTotal != int64(len(data)) {
+		t.Errorf("totalBytes = %d, want %d (bytes.Reader is a Seeker)", lastTotal, len(data))
+	}
+	if lastRecords != 2 {
+		t.Errorf("records = %d, want 2", lastRecords)
+	}
+}
+
+func TestStreamStopsOnParseError(t *testing.T) {
+	spec := cnab.RecordSpec{Fields: []cnab.FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	data := "0001\nxy\n0003"
+	err := Stream(context.Background(), strings.NewReader(data), spec, 10, func(Batch) error { return nil }, nil)
+	if err == nil {
+		t.Fatal("expected error for short line")
+	}
+}
+
+func TestStreamHonorsContextCancellation(t *testing.T) {
+	spec := cnab.RecordSpec{Fields: []cnab.FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := Stream(ctx, strings.NewReader("0001\n0002\n"), spec, 10, func(Batch) error { return nil }, nil)
+	if err == nil {
+		t.Fatal("expected context cancellation error")
+	}
+}
@@ -0,0 +1,26 @@
+package ingest
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/RafOSS-br/cnab-stream"
+)
+
+func TestWithProgressReportsWhenPositionalArgIsNil(t *testing.T) {
+	spec := cnab.RecordSpec{Fields: []cnab.FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	data := "0001\n0002\n"
+
+	var lastRecords int64
+	err := Stream(context.Background(), strings.NewReader(data), spec, 10, func(Batch) error { return nil }, nil,
+		WithProgress(func(bytesRead, totalBytes, records int64) {
+			lastRecords = records
+		}))
+	if err != nil {
+		t.Fatalf("Stream: %v", err)
+	}
+	if lastRecords != 2 {
+		t.Errorf("lastRecords = %d, want 2", lastRecords)
+	}
+}
@@ -0,0 +1,17 @@
+package ingest
+
+import (
+	"fmt"
+	"io"
+)
+
+// RejectsFileWriter returns a RejectFunc that appends one line per
+// rejected record to w, in the form "<lineNumber>: <error>: <raw
+// line>", producing a plain-text rejects file suitable for manual
+// correction workflows. Write errors are ignored, matching the
+// best-effort nature of a diagnostics sink.
+func RejectsFileWriter(w io.Writer) RejectFunc {
+	return func(lineNumber int, line string, err error) {
+		fmt.Fprintf(w, "%d: %v: %s\n", lineNumber, err, line)
+	}
+}
@@ -0,0 +1,32 @@
+package ingest
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/RafOSS-br/cnab-stream"
+)
+
+func TestStreamWithRejectsContinuesPastBadLines(t *testing.T) {
+	spec := cnab.RecordSpec{Fields: []cnab.FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	data := strings.Join([]string{"0001", "x", "0003"}, "\n")
+
+	var buf bytes.Buffer
+	var batches []Batch
+	err := StreamWithRejects(context.Background(), strings.NewReader(data), spec, 10, func(b Batch) error {
+		batches = append(batches, b)
+		return nil
+	}, nil, RejectsFileWriter(&buf))
+	if err != nil {
+		t.Fatalf("StreamWithRejects: %v", err)
+	}
+
+	if len(batches) != 1 || len(batches[0].Records) != 2 {
+		t.Fatalf("got %v, want a single batch of 2 good records", batches)
+	}
+	if !strings.Contains(buf.String(), "2:") || !strings.Contains(buf.String(), "x") {
+		t.Errorf("rejects file = %q, want an entry for line 2 containing the raw line", buf.String())
+	}
+}
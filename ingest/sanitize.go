@@ -0,0 +1,75 @@
+package ingest
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// Summary reports defects observed while streaming a file that are
+// suspicious but not fatal enough for Stream/StreamWithRejects to abort
+// on their own — the kind of thing a hand-edited bank file tends to
+// accumulate. Pass a *Summary to WithSummary to have it filled in by the
+// time Stream/StreamWithRejects returns.
+type Summary struct {
+	// BOMStripped reports whether a leading UTF-8 byte-order mark was
+	// found and discarded before the first line was read.
+	BOMStripped bool
+	// CRLFCount and LFCount count lines terminated by "\r\n" and "\n"
+	// respectively. Seeing both above zero in the same file means
+	// MixedTerminators is set.
+	CRLFCount int
+	LFCount   int
+	// MixedTerminators reports whether the file used more than one line
+	// terminator convention, a common symptom of a file edited or
+	// concatenated across Windows and Unix tools.
+	MixedTerminators bool
+	// LengthAnomalies lists lines whose length fell outside the
+	// tolerance configured via WithLengthTolerance.
+	LengthAnomalies []LengthAnomaly
+}
+
+// LengthAnomaly records one line whose length was unexpected.
+type LengthAnomaly struct {
+	Line   int
+	Length int
+}
+
+// bomPrefix is the UTF-8 encoding of the byte-order mark some Windows-
+// authored CNAB files carry at the start of the file, which would
+// otherwise corrupt the first record's first field.
+var bomPrefix = []byte{0xEF, 0xBB, 0xBF}
+
+// stripBOM wraps r in a buffered reader with any leading UTF-8 BOM
+// already consumed, reporting whether one was found.
+func stripBOM(r io.Reader) (io.Reader, bool) {
+	br := bufio.NewReader(r)
+	peek, _ := br.Peek(len(bomPrefix))
+	if bytes.Equal(peek, bomPrefix) {
+		_, _ = br.Discard(len(bomPrefix))
+		return br, true
+	}
+	return br, false
+}
+
+// splitLinesTrackingTerminators behaves like bufio.ScanLines, additionally
+// tallying "\r\n" vs "\n" terminators into summary as it goes.
+func splitLinesTrackingTerminators(summary *Summary) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			if i > 0 && data[i-1] == '\r' {
+				summary.CRLFCount++
+				return i + 1, data[:i-1], nil
+			}
+			summary.LFCount++
+			return i + 1, data[:i], nil
+		}
+		if atEOF {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	}
+}
@@ -0,0 +1,65 @@
+package ingest
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/RafOSS-br/cnab-stream"
+)
+
+func TestStreamStripsLeadingBOM(t *testing.T) {
+	spec := cnab.RecordSpec{Fields: []cnab.FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	data := "\xEF\xBB\xBF0001\n0002\n"
+
+	var summary Summary
+	var recs []*cnab.Record
+	err := Stream(context.Background(), strings.NewReader(data), spec, 10, func(b Batch) error {
+		recs = append(recs, b.Records...)
+		return nil
+	}, nil, WithSummary(&summary))
+	if err != nil {
+		t.Fatalf("Stream: %v", err)
+	}
+	if !summary.BOMStripped {
+		t.Error("expected BOMStripped to be true")
+	}
+	if got, _ := recs[0].Get("id"); got != "0001" {
+		t.Errorf("first record id = %q, want 0001 (BOM should not leak into it)", got)
+	}
+}
+
+func TestStreamDetectsMixedLineTerminators(t *testing.T) {
+	spec := cnab.RecordSpec{Fields: []cnab.FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	data := "0001\r\n0002\n0003\r\n"
+
+	var summary Summary
+	err := Stream(context.Background(), strings.NewReader(data), spec, 10, func(Batch) error { return nil }, nil, WithSummary(&summary))
+	if err != nil {
+		t.Fatalf("Stream: %v", err)
+	}
+	if !summary.MixedTerminators {
+		t.Error("expected MixedTerminators to be true")
+	}
+	if summary.CRLFCount != 2 || summary.LFCount != 1 {
+		t.Errorf("CRLFCount=%d LFCount=%d, want 2,1", summary.CRLFCount, summary.LFCount)
+	}
+}
+
+func TestStreamReportsLengthAnomalies(t *testing.T) {
+	spec := cnab.RecordSpec{Fields: []cnab.FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	data := "0001\n0002extra\n"
+
+	var summary Summary
+	err := Stream(context.Background(), strings.NewReader(data), spec, 10, func(Batch) error { return nil }, nil,
+		WithSummary(&summary), WithLengthTolerance(4, 0))
+	if err != nil {
+		t.Fatalf("Stream: %v", err)
+	}
+	if len(summary.LengthAnomalies) != 1 {
+		t.Fatalf("got %d anomalies, want 1", len(summary.LengthAnomalies))
+	}
+	if summary.LengthAnomalies[0].Line != 2 || summary.LengthAnomalies[0].Length != 9 {
+		t.Errorf("anomaly = %+v, want {Line:2 Length:9}", summary.LengthAnomalies[0])
+	}
+}
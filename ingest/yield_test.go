@@ -0,0 +1,44 @@
+package ingest
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/RafOSS-br/cnab-stream"
+)
+
+func TestWithYieldEveryStillHonorsCancellationEventually(t *testing.T) {
+	spec := cnab.RecordSpec{Fields: []cnab.FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	lines := make([]string, 20)
+	for i := range lines {
+		lines[i] = "0001"
+	}
+	data := strings.Join(lines, "\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	count := 0
+	err := Stream(ctx, strings.NewReader(data), spec, 1, func(Batch) error {
+		count++
+		if count == 5 {
+			cancel()
+		}
+		return nil
+	}, nil, WithYieldEvery(3))
+	if err == nil {
+		t.Fatal("expected context cancellation to eventually stop the stream")
+	}
+	if count >= len(lines) {
+		t.Errorf("processed all %d lines, cancellation should have cut it short", count)
+	}
+}
+
+func TestWithYieldEveryDefaultsToCheckingEveryLine(t *testing.T) {
+	spec := cnab.RecordSpec{Fields: []cnab.FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := Stream(ctx, strings.NewReader("0001\n0002\n"), spec, 10, func(Batch) error { return nil }, nil)
+	if err == nil {
+		t.Fatal("expected immediate cancellation error with default yield")
+	}
+}
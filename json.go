@@ -0,0 +1,66 @@
+package cnab
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON renders the record as a JSON object with keys in the same
+// order as Fields(). The JSON spec does not guarantee object key order is
+// preserved by readers, but most tooling (and every JSON text diff) does
+// preserve it, which is what export and diff tooling need.
+func (r *Record) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, fv := range r.Fields() {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(fv.Name)
+		if err != nil {
+			return nil, err
+		}
+		val, err := json.Marshal(fv.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(val)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON populates the record from a JSON object, preserving the
+// key order found in the input using a streaming token decoder (Go's
+// encoding/json otherwise normalizes objects into unordered maps).
+func (r *Record) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '{' {
+		return fmt.Errorf("cnab: expected JSON object")
+	}
+
+	*r = *NewRecord()
+	for dec.More() {
+		keyTok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := keyTok.(string)
+		if !ok {
+			return fmt.Errorf("cnab: expected string key")
+		}
+		var value string
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		r.Set(key, value)
+	}
+	return nil
+}
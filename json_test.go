@@ -0,0 +1,37 @@
+package cnab
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRecordJSONRoundTrip(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "agency", Start: 0, Length: 4},
+		{Name: "name", Start: 4, Length: 5},
+	}}
+	rec, err := ParseRecord(spec, "0001ALICE")
+	if err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	want := `{"agency":"0001","name":"ALICE"}`
+	if string(data) != want {
+		t.Errorf("got %s, want %s", data, want)
+	}
+
+	var round Record
+	if err := json.Unmarshal(data, &round); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got, _ := round.Get("name"); got != "ALICE" {
+		t.Errorf("name = %q, want ALICE", got)
+	}
+	if round.Fields()[0].Name != "agency" {
+		t.Errorf("order not preserved: %+v", round.Fields())
+	}
+}
@@ -0,0 +1,52 @@
+package cnab
+
+import "encoding/json"
+
+// ToJSONSchema renders spec as a JSON Schema (draft 2020-12 subset)
+// object describing the shape ParseRecord produces once marshaled via
+// Record.MarshalJSON: a JSON object with one string property per field,
+// each constrained to its fixed width, plus a "required" list for fields
+// carrying a "required" validator or FieldSpec.Required. This gives API
+// consumers and codegen tools a machine-readable contract without
+// hand-transcribing the bank's layout manual.
+func (spec RecordSpec) ToJSONSchema() ([]byte, error) {
+	properties := make(map[string]interface{}, len(spec.Fields))
+	var required []string
+	for _, f := range spec.Fields {
+		prop := map[string]interface{}{
+			"type":      "string",
+			"maxLength": f.Length,
+		}
+		if f.Type == fieldTypeBigInt {
+			prop["pattern"] = "^[+-]?[0-9]+$"
+		}
+		if f.Description != "" {
+			prop["description"] = f.Description
+		}
+		properties[f.Name] = prop
+
+		isRequired := f.Required
+		for _, rule := range f.Validators {
+			if rule == "required" {
+				isRequired = true
+				break
+			}
+		}
+		if isRequired {
+			required = append(required, f.Name)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"$schema":    "https://json-schema.org/draft/2020-12/schema",
+		"type":       "object",
+		"properties": properties,
+	}
+	if spec.Type != "" {
+		schema["title"] = spec.Type
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return json.MarshalIndent(schema, "", "  ")
+}
@@ -0,0 +1,46 @@
+package cnab
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestToJSONSchemaDescribesFieldsAndRequired(t *testing.T) {
+	spec := RecordSpec{
+		Type: "detail",
+		Fields: []FieldSpec{
+			{Name: "id", Start: 0, Length: 4, Validators: []string{"required"}},
+			{Name: "amount", Start: 4, Length: 10},
+			{Name: "nosso_numero", Start: 14, Length: 25, Type: "bigint"},
+		},
+	}
+
+	data, err := spec.ToJSONSchema()
+	if err != nil {
+		t.Fatalf("ToJSONSchema: %v", err)
+	}
+
+	var schema map[string]interface{}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if schema["title"] != "detail" {
+		t.Errorf("title = %v, want detail", schema["title"])
+	}
+	props, ok := schema["properties"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("properties is not an object: %v", schema["properties"])
+	}
+	idProp, ok := props["id"].(map[string]interface{})
+	if !ok || idProp["maxLength"] != float64(4) {
+		t.Errorf("id property = %v, want maxLength 4", props["id"])
+	}
+	bigintProp, ok := props["nosso_numero"].(map[string]interface{})
+	if !ok || bigintProp["pattern"] == nil {
+		t.Errorf("nosso_numero property = %v, want a pattern constraint", props["nosso_numero"])
+	}
+	required, ok := schema["required"].([]interface{})
+	if !ok || len(required) != 1 || required[0] != "id" {
+		t.Errorf("required = %v, want [id]", schema["required"])
+	}
+}
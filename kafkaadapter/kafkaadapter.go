@@ -0,0 +1,67 @@
+// Package kafkaadapter implements streampipeline.Source,
+// streampipeline.Sink and streampipeline.DeadLetterQueue on top of
+// segmentio/kafka-go, so a CNAB ingestion pipeline can read raw lines
+// from one topic and write parsed JSON (or rejects) to others.
+//
+// This is a separate module (its own go.mod) so the core
+// github.com/RafOSS-br/cnab-stream module and streampipeline stay free
+// of the kafka-go dependency for callers using a different transport.
+package kafkaadapter
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/RafOSS-br/cnab-stream/streampipeline"
+	kafka "github.com/segmentio/kafka-go"
+)
+
+// Source reads raw CNAB lines from a Kafka topic via an underlying
+// *kafka.Reader.
+type Source struct {
+	Reader *kafka.Reader
+}
+
+func (s *Source) Next(ctx context.Context) (streampipeline.Message, error) {
+	msg, err := s.Reader.ReadMessage(ctx)
+	if err != nil {
+		return streampipeline.Message{}, err
+	}
+	return streampipeline.Message{Value: msg.Value}, nil
+}
+
+// Sink publishes parsed record JSON to a Kafka topic via an underlying
+// *kafka.Writer.
+type Sink struct {
+	Writer *kafka.Writer
+}
+
+func (s *Sink) Publish(ctx context.Context, value []byte) error {
+	return s.Writer.WriteMessages(ctx, kafka.Message{Value: value})
+}
+
+// DeadLetterQueue publishes rejected lines, along with the parse error
+// that caused the rejection, to a Kafka dead-letter topic via an
+// underlying *kafka.Writer.
+type DeadLetterQueue struct {
+	Writer *kafka.Writer
+}
+
+type rejectedRecord struct {
+	Line  string `json:"line"`
+	Error string `json:"error"`
+}
+
+func (d *DeadLetterQueue) Reject(ctx context.Context, msg streampipeline.Message, cause error) error {
+	encoded, err := json.Marshal(rejectedRecord{Line: string(msg.Value), Error: cause.Error()})
+	if err != nil {
+		return err
+	}
+	return d.Writer.WriteMessages(ctx, kafka.Message{Value: encoded})
+}
+
+var (
+	_ streampipeline.Source          = (*Source)(nil)
+	_ streampipeline.Sink            = (*Sink)(nil)
+	_ streampipeline.DeadLetterQueue = (*DeadLetterQueue)(nil)
+)
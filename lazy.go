@@ -0,0 +1,64 @@
+package cnab
+
+// LazyRecord wraps a raw line and its RecordSpec without eagerly parsing
+// any field, decoding (and validating) each field only the first time it
+// is requested via Get. This avoids the cost of parsing fields a caller
+// never looks at, e.g. when scanning a large file for a handful of
+// interesting records.
+type LazyRecord struct {
+	spec  RecordSpec
+	line  string
+	cache map[string]string
+	errs  map[string]error
+}
+
+// NewLazyRecord creates a LazyRecord for line, to be decoded against
+// spec on demand.
+func NewLazyRecord(spec RecordSpec, line string) *LazyRecord {
+	return &LazyRecord{spec: spec, line: line, cache: make(map[string]string)}
+}
+
+// Get decodes (or returns the cached decoding of) the named field.
+func (l *LazyRecord) Get(name string) (string, error) {
+	if v, ok := l.cache[name]; ok {
+		return v, nil
+	}
+	if err, ok := l.errs[name]; ok {
+		return "", err
+	}
+
+	f, ok := fieldByName(l.spec, name)
+	if !ok {
+		return "", &ParseError{Field: name, Msg: "field not declared in spec"}
+	}
+	if f.End() > len(l.line) {
+		err := &ParseError{Field: f.Name, Msg: "field extends beyond end of line", Start: f.Start, End: f.End()}
+		l.recordErr(name, err)
+		return "", err
+	}
+
+	value := l.line[f.Start:f.End()]
+	if len(f.PreParse) > 0 {
+		transformed, err := applyTransforms(f.PreParse, value)
+		if err != nil {
+			pe := &ParseError{Field: f.Name, Msg: err.Error(), Start: f.Start, End: f.End()}
+			l.recordErr(name, pe)
+			return "", pe
+		}
+		value = transformed
+	}
+	if err := validateField(f, value); err != nil {
+		l.recordErr(name, err)
+		return "", err
+	}
+
+	l.cache[name] = value
+	return value, nil
+}
+
+func (l *LazyRecord) recordErr(name string, err error) {
+	if l.errs == nil {
+		l.errs = make(map[string]error)
+	}
+	l.errs[name] = err
+}
@@ -0,0 +1,25 @@
+package cnab
+
+import "testing"
+
+func TestLazyRecordDecodesOnDemand(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "type", Start: 0, Length: 1},
+		{Name: "amount", Start: 1, Length: 4, Validators: []string{"max:9999"}},
+	}}
+	lazy := NewLazyRecord(spec, "H0500")
+
+	v, err := lazy.Get("type")
+	if err != nil || v != "H" {
+		t.Fatalf("Get(type) = %q, %v", v, err)
+	}
+	// second call should hit the cache and still succeed
+	v, err = lazy.Get("type")
+	if err != nil || v != "H" {
+		t.Fatalf("cached Get(type) = %q, %v", v, err)
+	}
+
+	if _, err := lazy.Get("missing"); err == nil {
+		t.Fatal("expected error for undeclared field")
+	}
+}
@@ -0,0 +1,65 @@
+package cnab
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LintSpec checks spec for issues that are not outright invalid (see
+// ValidateSpec for those) but likely indicate a mistake: duplicate field
+// names, gaps between consecutive fields, and unknown validator/transform
+// rule names. It returns one warning string per issue found; an empty
+// result means the spec looks clean.
+func LintSpec(spec RecordSpec) []string {
+	var warnings []string
+
+	seen := make(map[string]bool)
+	for _, f := range spec.Fields {
+		if f.Name == "" {
+			warnings = append(warnings, "field has empty name")
+			continue
+		}
+		if seen[f.Name] {
+			warnings = append(warnings, fmt.Sprintf("field %q is declared more than once", f.Name))
+		}
+		seen[f.Name] = true
+
+		for _, rule := range f.Validators {
+			name, _, _ := strings.Cut(rule, ":")
+			if _, ok := validatorRegistry[name]; !ok {
+				warnings = append(warnings, fmt.Sprintf("field %q uses unknown validator %q", f.Name, name))
+			}
+		}
+		for _, rule := range f.PreParse {
+			name, _, _ := strings.Cut(rule, ":")
+			if _, ok := transformRegistry[name]; !ok {
+				warnings = append(warnings, fmt.Sprintf("field %q uses unknown pre-parse transform %q", f.Name, name))
+			}
+		}
+		for _, rule := range f.PostFormat {
+			name, _, _ := strings.Cut(rule, ":")
+			if _, ok := transformRegistry[name]; !ok {
+				warnings = append(warnings, fmt.Sprintf("field %q uses unknown post-format transform %q", f.Name, name))
+			}
+		}
+	}
+
+	ordered := append([]FieldSpec(nil), spec.Fields...)
+	sortFieldsByStart(ordered)
+	for i := 1; i < len(ordered); i++ {
+		prev, cur := ordered[i-1], ordered[i]
+		if cur.Start > prev.End() {
+			warnings = append(warnings, fmt.Sprintf("gap of %d byte(s) between %q and %q", cur.Start-prev.End(), prev.Name, cur.Name))
+		}
+	}
+
+	return warnings
+}
+
+func sortFieldsByStart(fields []FieldSpec) {
+	for i := 1; i < len(fields); i++ {
+		for j := i; j > 0 && fields[j].Start < fields[j-1].Start; j-- {
+			fields[j], fields[j-1] = fields[j-1], fields[j]
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package cnab
+
+import "testing"
+
+func TestLintSpecFindsIssues(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "a", Start: 0, Length: 2, Validators: []string{"bogus"}},
+		{Name: "a", Start: 4, Length: 2},
+	}}
+	warnings := LintSpec(spec)
+	if len(warnings) < 2 {
+		t.Fatalf("expected at least 2 warnings, got %v", warnings)
+	}
+}
+
+func TestLintSpecClean(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "a", Start: 0, Length: 2},
+		{Name: "b", Start: 2, Length: 2},
+	}}
+	if warnings := LintSpec(spec); len(warnings) != 0 {
+		t.Errorf("expected no warnings, got %v", warnings)
+	}
+}
@@ -0,0 +1,60 @@
+package cnab
+
+import (
+	"fmt"
+	"sync"
+)
+
+// localeMu guards defaultLocale.
+var localeMu sync.RWMutex
+
+// defaultLocale is the package-wide locale used by ParseError.LocalizedError
+// and Processor.LocalizeError when neither specifies one of its own. The
+// zero value "" renders English, ParseError.Error()'s long-standing
+// behavior.
+var defaultLocale string
+
+// SetLocale sets the package-wide default locale used to render
+// *ParseError messages, e.g. SetLocale("pt-BR") so validation reports
+// shown to Brazilian back-office operators read in Portuguese.
+// ParseError.Code (see ErrorCode) is unaffected and remains stable
+// across locales; only the human-readable wording changes.
+func SetLocale(locale string) {
+	localeMu.Lock()
+	defer localeMu.Unlock()
+	defaultLocale = locale
+}
+
+func currentLocale() string {
+	localeMu.RLock()
+	defer localeMu.RUnlock()
+	return defaultLocale
+}
+
+// messageCatalog maps a ParseError.Code to a per-locale message template,
+// formatted with the field name via fmt.Sprintf's %q verb. Locale "" is
+// not listed: a ParseError with no matching catalog entry (including an
+// uncategorized one, Code == "") falls back to Error()'s English wording.
+var messageCatalog = map[string]map[string]string{
+	CodeFieldOutOfBounds:   {"pt-BR": "campo %q ultrapassa o limite do registro"},
+	CodeFieldOverflow:      {"pt-BR": "valor do campo %q excede o tamanho máximo"},
+	CodeValidationFailed:   {"pt-BR": "campo %q não passou na validação"},
+	CodeInvalidBigInt:      {"pt-BR": "campo %q contém um número inválido"},
+	CodeStructuralMismatch: {"pt-BR": "estrutura do arquivo está inconsistente perto do campo %q"},
+}
+
+// Localize renders e's message in locale, falling back to e.Error()'s
+// English wording when locale has no catalog entry for e.Code.
+func (e *ParseError) Localize(locale string) string {
+	if tpl, ok := messageCatalog[e.Code][locale]; ok {
+		return fmt.Sprintf(tpl, e.Field)
+	}
+	return e.Error()
+}
+
+// LocalizedError renders e's message using the package-wide locale set by
+// SetLocale, falling back to e.Error()'s English wording when no locale
+// is set or the catalog has no entry for e.Code.
+func (e *ParseError) LocalizedError() string {
+	return e.Localize(currentLocale())
+}
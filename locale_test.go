@@ -0,0 +1,58 @@
+package cnab
+
+import "testing"
+
+func TestParseErrorLocalizeFallsBackWithoutCatalogEntry(t *testing.T) {
+	err := &ParseError{Field: "id", Msg: "uncategorized", Code: ""}
+	if got, want := err.Localize("pt-BR"), err.Error(); got != want {
+		t.Errorf("Localize(%q) = %q, want %q", "pt-BR", got, want)
+	}
+}
+
+func TestParseErrorLocalizeTranslatesKnownCode(t *testing.T) {
+	err := &ParseError{Field: "valor", Msg: "value exceeds length", Code: CodeFieldOverflow}
+	got := err.Localize("pt-BR")
+	want := `valor do campo "valor" excede o tamanho máximo`
+	if got != want {
+		t.Errorf("Localize(%q) = %q, want %q", "pt-BR", got, want)
+	}
+}
+
+func TestSetLocaleAffectsLocalizedError(t *testing.T) {
+	t.Cleanup(func() { SetLocale("") })
+	err := &ParseError{Field: "id", Msg: "field out of bounds", Code: CodeFieldOutOfBounds}
+
+	SetLocale("")
+	if got, want := err.LocalizedError(), err.Error(); got != want {
+		t.Errorf("LocalizedError() with no locale = %q, want %q", got, want)
+	}
+
+	SetLocale("pt-BR")
+	if got, want := err.LocalizedError(), `campo "id" ultrapassa o limite do registro`; got != want {
+		t.Errorf("LocalizedError() with pt-BR = %q, want %q", got, want)
+	}
+}
+
+func TestProcessorLocalizeErrorUsesProcessorLocaleOverDefault(t *testing.T) {
+	t.Cleanup(func() { SetLocale("") })
+	SetLocale("pt-BR")
+
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	p := NewProcessor(spec)
+	_, err := p.ParseRecord("12")
+	if err == nil {
+		t.Fatal("expected a parse error for a short line")
+	}
+	if got, want := p.LocalizeError(err), `campo "id" ultrapassa o limite do registro`; got != want {
+		t.Errorf("LocalizeError() = %q, want %q", got, want)
+	}
+
+	pEnglish := NewProcessor(spec, WithLocale("en"))
+	_, err = pEnglish.ParseRecord("12")
+	if err == nil {
+		t.Fatal("expected a parse error for a short line")
+	}
+	if got, want := pEnglish.LocalizeError(err), err.Error(); got != want {
+		t.Errorf("LocalizeError() with WithLocale(\"en\") = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,46 @@
+package cnab
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestProcessorWithLoggerWarnsOnParseFailure(t *testing.T) {
+	spec := RecordSpec{Type: "detail", Fields: []FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	p := NewProcessor(spec, WithLogger(logger))
+
+	if _, err := p.ParseRecord("x"); err == nil {
+		t.Fatal("expected error for short line")
+	}
+	if !strings.Contains(buf.String(), "parse failed") {
+		t.Errorf("log output = %q, want a parse failed warning", buf.String())
+	}
+
+	buf.Reset()
+	if _, err := p.ParseRecord("0001"); err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+	if !strings.Contains(buf.String(), "record parsed") {
+		t.Errorf("log output = %q, want a debug record parsed entry", buf.String())
+	}
+}
+
+func TestReloadLoggedReportsOutcome(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	r := NewRegistry()
+
+	err := r.ReloadLogged("001", "v1", func() (FileSpec, error) {
+		return FileSpec{}, nil
+	}, logger)
+	if err != nil {
+		t.Fatalf("ReloadLogged: %v", err)
+	}
+	if !strings.Contains(buf.String(), "spec reloaded") {
+		t.Errorf("log output = %q, want a spec reloaded entry", buf.String())
+	}
+}
@@ -0,0 +1,35 @@
+package cnab
+
+import "strings"
+
+// maskPlaceholder is the redaction used in place of a sensitive field's
+// value, sized to the original value so masked output does not leak the
+// field's length as a side channel more than the layout already does.
+func maskPlaceholder(value string) string {
+	return strings.Repeat("*", len(value))
+}
+
+// MaskRecord returns a copy of rec with every field marked Sensitive in
+// spec replaced by a same-length run of '*'. Fields not present in spec
+// are copied unchanged. The original rec is not modified, and its
+// unmasked values remain reachable via rec.Get and json.Marshal(rec) —
+// MaskRecord is an opt-in view for output paths (logs, masked JSON) that
+// must not leak PII.
+func MaskRecord(spec RecordSpec, rec *Record) *Record {
+	sensitive := make(map[string]bool, len(spec.Fields))
+	for _, f := range spec.Fields {
+		if f.Sensitive {
+			sensitive[f.Name] = true
+		}
+	}
+
+	masked := NewRecord()
+	for _, fv := range rec.Fields() {
+		if sensitive[fv.Name] {
+			masked.Set(fv.Name, maskPlaceholder(fv.Value))
+		} else {
+			masked.Set(fv.Name, fv.Value)
+		}
+	}
+	return masked
+}
@@ -0,0 +1,50 @@
+package cnab
+
+import "testing"
+
+func TestMaskRecordRedactsSensitiveFields(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "cpf", Start: 0, Length: 11, Sensitive: true},
+		{Name: "name", Start: 11, Length: 10},
+	}}
+	rec := NewRecord()
+	rec.Set("cpf", "11144477735")
+	rec.Set("name", "JOHN DOE")
+
+	masked := MaskRecord(spec, rec)
+	if v, _ := masked.Get("cpf"); v != "***********" {
+		t.Errorf("Get(cpf) = %q, want all-asterisk redaction", v)
+	}
+	if v, _ := masked.Get("name"); v != "JOHN DOE" {
+		t.Errorf("Get(name) = %q, want unchanged", v)
+	}
+	if v, _ := rec.Get("cpf"); v != "11144477735" {
+		t.Errorf("MaskRecord mutated the original: cpf = %q", v)
+	}
+}
+
+func TestProcessorMaskedJSON(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "cpf", Start: 0, Length: 4, Sensitive: true},
+	}}
+	rec := NewRecord()
+	rec.Set("cpf", "1234")
+
+	unmasked := NewProcessor(spec)
+	data, err := unmasked.MaskedJSON(rec)
+	if err != nil {
+		t.Fatalf("MaskedJSON: %v", err)
+	}
+	if string(data) != `{"cpf":"1234"}` {
+		t.Errorf("MaskedJSON without WithFieldMasking = %s", data)
+	}
+
+	masked := NewProcessor(spec, WithFieldMasking(true))
+	data, err = masked.MaskedJSON(rec)
+	if err != nil {
+		t.Fatalf("MaskedJSON: %v", err)
+	}
+	if string(data) != `{"cpf":"****"}` {
+		t.Errorf("MaskedJSON with WithFieldMasking(true) = %s", data)
+	}
+}
@@ -0,0 +1,34 @@
+package cnab
+
+import "time"
+
+// Metrics receives instrumentation events from a Processor. Implementations
+// must be safe for concurrent use, since a Processor may be shared across
+// goroutines (e.g. ParseLinesParallel workers).
+type Metrics interface {
+	// RecordParsed is called once per successful Processor parse
+	// (ParseRecord, ParseRecordContext or ParseRecordAs).
+	RecordParsed()
+	// RecordPacked is called once per successful PackRecord.
+	RecordPacked()
+	// Error is called once per failed parse or pack, and once per line
+	// that fails Processor.ValidateFile, tagged with a short error kind
+	// ("parse_error", "pack_error" or "validate_error").
+	Error(kind string)
+	// BytesProcessed is called with the number of raw bytes read or
+	// written for a single record.
+	BytesProcessed(n int64)
+	// Latency is called with the wall-clock duration of a single
+	// operation, tagged by name (e.g. "parse", "pack").
+	Latency(op string, d time.Duration)
+}
+
+// NopMetrics is a Metrics implementation whose methods do nothing. It is
+// the default used by a Processor created without WithMetrics.
+type NopMetrics struct{}
+
+func (NopMetrics) RecordParsed()                      {}
+func (NopMetrics) RecordPacked()                      {}
+func (NopMetrics) Error(kind string)                  {}
+func (NopMetrics) BytesProcessed(n int64)             {}
+func (NopMetrics) Latency(op string, d time.Duration) {}
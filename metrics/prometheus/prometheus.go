@@ -0,0 +1,63 @@
+// Package prometheus adapts cnab.Metrics onto Prometheus client_golang
+// collectors, for processes that already expose a /metrics endpoint.
+//
+// This is a separate module (its own go.mod) so that the core
+// github.com/RafOSS-br/cnab-stream module stays free of the Prometheus
+// client dependency for callers who don't need it.
+package prometheus
+
+import (
+	"time"
+
+	"github.com/RafOSS-br/cnab-stream"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics implements cnab.Metrics on top of a set of Prometheus
+// collectors, registered against reg.
+type Metrics struct {
+	parsed  prometheus.Counter
+	packed  prometheus.Counter
+	errors  *prometheus.CounterVec
+	bytes   prometheus.Counter
+	latency *prometheus.HistogramVec
+}
+
+// NewMetrics creates and registers the collectors backing Metrics
+// against reg, prefixing all metric names with "cnab_".
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		parsed: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "cnab_records_parsed_total",
+			Help: "Total number of records successfully parsed.",
+		}),
+		packed: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "cnab_records_packed_total",
+			Help: "Total number of records successfully packed.",
+		}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "cnab_errors_total",
+			Help: "Total number of parse/pack errors, by kind.",
+		}, []string{"kind"}),
+		bytes: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "cnab_bytes_processed_total",
+			Help: "Total number of raw bytes parsed or packed.",
+		}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "cnab_operation_duration_seconds",
+			Help: "Latency of parse/pack operations, by operation name.",
+		}, []string{"op"}),
+	}
+	reg.MustRegister(m.parsed, m.packed, m.errors, m.bytes, m.latency)
+	return m
+}
+
+func (m *Metrics) RecordParsed()          { m.parsed.Inc() }
+func (m *Metrics) RecordPacked()          { m.packed.Inc() }
+func (m *Metrics) Error(kind string)      { m.errors.WithLabelValues(kind).Inc() }
+func (m *Metrics) BytesProcessed(n int64) { m.bytes.Add(float64(n)) }
+func (m *Metrics) Latency(op string, d time.Duration) {
+	m.latency.WithLabelValues(op).Observe(d.Seconds())
+}
+
+var _ cnab.Metrics = (*Metrics)(nil)
@@ -0,0 +1,56 @@
+package cnab
+
+import (
+	"testing"
+	"time"
+)
+
+type countingMetrics struct {
+	parsed, packed int
+	errors         map[string]int
+	bytes          int64
+}
+
+func newCountingMetrics() *countingMetrics {
+	return &countingMetrics{errors: map[string]int{}}
+}
+
+func (m *countingMetrics) RecordParsed()                 { m.parsed++ }
+func (m *countingMetrics) RecordPacked()                 { m.packed++ }
+func (m *countingMetrics) Error(kind string)             { m.errors[kind]++ }
+func (m *countingMetrics) BytesProcessed(n int64)        { m.bytes += n }
+func (m *countingMetrics) Latency(string, time.Duration) {}
+
+func TestProcessorReportsMetrics(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	m := newCountingMetrics()
+	p := NewProcessor(spec, WithMetrics(m))
+
+	if _, err := p.ParseRecord("0001"); err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+	if _, err := p.ParseRecord("x"); err == nil {
+		t.Fatal("expected error for short line")
+	}
+	if _, err := p.PackRecord(map[string]string{"id": "0002"}); err != nil {
+		t.Fatalf("PackRecord: %v", err)
+	}
+
+	if m.parsed != 1 || m.packed != 1 {
+		t.Errorf("parsed=%d packed=%d, want 1 and 1", m.parsed, m.packed)
+	}
+	if m.errors["parse_error"] != 1 {
+		t.Errorf("errors[parse_error]=%d, want 1", m.errors["parse_error"])
+	}
+	if m.bytes == 0 {
+		t.Error("expected non-zero bytes processed")
+	}
+}
+
+func TestNewProcessorDefaultsToNopMetrics(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	p := NewProcessor(spec)
+	if _, err := p.ParseRecord("0001"); err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package cnab
+
+import "fmt"
+
+// maxUint64 is the largest value a uint64 can hold, split into a cutoff
+// quotient and digit so overflow can be detected one digit ahead of
+// accumulating it, without ever computing a value that has already
+// wrapped around.
+const (
+	maxUint64       = 1<<64 - 1
+	maxUint64Cutoff = maxUint64 / 10
+	maxUint64Digit  = maxUint64 % 10
+)
+
+// ErrNumericOverflow indicates a numeric field held more digits than fit
+// in a uint64 accumulator (roughly 20 digits), which occurs for
+// long identifiers like a 25-digit nosso número that are numeric-looking
+// but not meant to be arithmetically summed.
+type ErrNumericOverflow struct {
+	Value string
+}
+
+func (e *ErrNumericOverflow) Error() string {
+	return fmt.Sprintf("cnab: value %q overflows a 64-bit accumulator", e.Value)
+}
+
+// parseDigitsUint64 parses s, which must consist entirely of ASCII
+// digits, into a uint64, detecting overflow explicitly rather than
+// wrapping silently. It processes s in a straight byte loop with no
+// intermediate allocation, which the compiler can auto-vectorize far
+// more readily than strconv.ParseInt's general-purpose base/sign
+// handling — the dominant cost in a CNAB record, where the large
+// majority of fields are fixed-width unsigned digit runs.
+//
+// It returns an error for any non-digit byte, and *ErrNumericOverflow if
+// the value does not fit in 64 bits.
+func parseDigitsUint64(s string) (uint64, error) {
+	if len(s) == 0 {
+		return 0, fmt.Errorf("cnab: empty numeric value")
+	}
+	var n uint64
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("cnab: value %q contains a non-digit byte at position %d", s, i)
+		}
+		d := uint64(c - '0')
+		if n > maxUint64Cutoff || (n == maxUint64Cutoff && d > maxUint64Digit) {
+			return 0, &ErrNumericOverflow{Value: s}
+		}
+		n = n*10 + d
+	}
+	return n, nil
+}
+
+func init() {
+	RegisterValidator("digits", func(string) (Validator, error) {
+		return func(value string) error {
+			if _, err := parseDigitsUint64(value); err != nil {
+				return err
+			}
+			return nil
+		}, nil
+	})
+}
@@ -0,0 +1,52 @@
+package cnab
+
+import "testing"
+
+func TestParseDigitsUint64(t *testing.T) {
+	cases := []struct {
+		in      string
+		want    uint64
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"000123", 123, false},
+		{"18446744073709551615", 18446744073709551615, false}, // math.MaxUint64
+		{"18446744073709551616", 0, true},                     // MaxUint64 + 1
+		{"99999999999999999999999999", 0, true},               // 26 digits, way over
+		{"12a3", 0, true},
+		{"", 0, true},
+	}
+	for _, c := range cases {
+		got, err := parseDigitsUint64(c.in)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("parseDigitsUint64(%q) = %d, nil, want an error", c.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseDigitsUint64(%q) unexpected error: %v", c.in, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("parseDigitsUint64(%q) = %d, want %d", c.in, got, c.want)
+		}
+	}
+}
+
+func TestParseDigitsUint64OverflowIsErrNumericOverflow(t *testing.T) {
+	_, err := parseDigitsUint64("999999999999999999999")
+	if _, ok := err.(*ErrNumericOverflow); !ok {
+		t.Fatalf("got error %v (%T), want *ErrNumericOverflow", err, err)
+	}
+}
+
+func TestDigitsValidatorRejectsNonNumeric(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "seq", Start: 0, Length: 4, Validators: []string{"digits"}}}}
+	if _, err := ParseRecord(spec, "12a3"); err == nil {
+		t.Fatal("expected digits validator to reject a non-numeric value")
+	}
+	if _, err := ParseRecord(spec, "0042"); err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+}
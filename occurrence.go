@@ -0,0 +1,43 @@
+package cnab
+
+// OccurrenceTable maps return-code ("ocorrência") values, as found in
+// retorno file detail records, to a human-readable description. Banks
+// mostly share the same base codes; callers can layer bank-specific
+// tables on top with a plain map merge.
+type OccurrenceTable map[string]string
+
+// StandardOccurrences holds the CNAB occurrence codes common across most
+// Brazilian banks' retorno layouts.
+var StandardOccurrences = OccurrenceTable{
+	"02": "Entrada confirmada",
+	"03": "Entrada rejeitada",
+	"06": "Liquidação normal",
+	"09": "Baixado automaticamente via arquivo",
+	"10": "Baixado conforme instruções da agência",
+	"11": "Em ser - arquivo de títulos pendentes",
+	"12": "Abatimento concedido",
+	"13": "Abatimento cancelado",
+	"14": "Vencimento alterado",
+	"15": "Liquidação em cartório",
+	"17": "Liquidação após baixa ou título não registrado",
+	"19": "Confirmação de recebimento de instrução de protesto",
+	"20": "Confirmação de recebimento de instrução de sustação de protesto",
+	"23": "Remessa a cartório",
+	"24": "Retirada de cartório e manutenção em carteira",
+	"25": "Protestado e baixado",
+	"26": "Instrução rejeitada",
+	"27": "Confirmação do pedido de alteração de outros dados",
+	"28": "Débito de tarifas/custas",
+}
+
+// Describe returns the description registered for code, and whether one
+// was found.
+func (t OccurrenceTable) Describe(code string) (string, bool) {
+	desc, ok := t[code]
+	return desc, ok
+}
+
+// DescribeOccurrence looks up code in StandardOccurrences.
+func DescribeOccurrence(code string) (string, bool) {
+	return StandardOccurrences.Describe(code)
+}
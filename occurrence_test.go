@@ -0,0 +1,13 @@
+package cnab
+
+import "testing"
+
+func TestDescribeOccurrence(t *testing.T) {
+	desc, ok := DescribeOccurrence("06")
+	if !ok || desc != "Liquidação normal" {
+		t.Errorf("got %q, %v", desc, ok)
+	}
+	if _, ok := DescribeOccurrence("99"); ok {
+		t.Error("expected no entry for unknown code")
+	}
+}
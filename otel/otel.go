@@ -0,0 +1,72 @@
+// Package otel adapts cnab's minimal Tracer/Span interfaces onto the
+// real OpenTelemetry SDK, for callers who already export traces via
+// OTel and want cnab spans in the same pipeline.
+//
+// This is a separate module (its own go.mod) so the core
+// github.com/RafOSS-br/cnab-stream module stays free of the OTel
+// dependency for callers who don't need it.
+package otel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/RafOSS-br/cnab-stream"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// TracerProvider adapts an OTel trace.TracerProvider to cnab.TracerProvider.
+type TracerProvider struct {
+	inner oteltrace.TracerProvider
+}
+
+// NewTracerProvider wraps an OTel TracerProvider for use with
+// cnab.WithTracerProvider / cnab.SetTracerProvider.
+func NewTracerProvider(inner oteltrace.TracerProvider) *TracerProvider {
+	return &TracerProvider{inner: inner}
+}
+
+func (p *TracerProvider) Tracer(name string) cnab.Tracer {
+	return &tracer{inner: p.inner.Tracer(name)}
+}
+
+type tracer struct {
+	inner oteltrace.Tracer
+}
+
+func (t *tracer) Start(ctx context.Context, spanName string) (context.Context, cnab.Span) {
+	ctx, span := t.inner.Start(ctx, spanName)
+	return ctx, &spanAdapter{inner: span}
+}
+
+type spanAdapter struct {
+	inner oteltrace.Span
+}
+
+func (s *spanAdapter) SetAttribute(key string, value interface{}) {
+	s.inner.SetAttributes(attributeFor(key, value))
+}
+
+func (s *spanAdapter) End() {
+	s.inner.End()
+}
+
+func attributeFor(key string, value interface{}) attribute.KeyValue {
+	switch v := value.(type) {
+	case string:
+		return attribute.String(key, v)
+	case int:
+		return attribute.Int(key, v)
+	case int64:
+		return attribute.Int64(key, v)
+	case bool:
+		return attribute.Bool(key, v)
+	case float64:
+		return attribute.Float64(key, v)
+	default:
+		return attribute.String(key, fmt.Sprint(v))
+	}
+}
+
+var _ cnab.TracerProvider = (*TracerProvider)(nil)
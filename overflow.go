@@ -0,0 +1,17 @@
+package cnab
+
+// Values for FieldSpec.OnOverflow.
+const (
+	// OnOverflowError makes PackRecordContext reject a too-long value
+	// for this field, regardless of WithStrictMode.
+	OnOverflowError = "error"
+	// OnOverflowTruncate truncates a too-long value silently, the
+	// default behavior when OnOverflow is unset and WithStrictMode is
+	// disabled.
+	OnOverflowTruncate = "truncate"
+	// OnOverflowTruncateWarn truncates a too-long value but reports the
+	// truncation through the Processor's logger, for fields (like a
+	// beneficiary name) where silent truncation is acceptable in
+	// practice but worth knowing about.
+	OnOverflowTruncateWarn = "truncate_warn"
+)
@@ -0,0 +1,111 @@
+package cnab
+
+import (
+	"fmt"
+	"strings"
+)
+
+// overpunchPositive and overpunchNegative map the zoned-decimal
+// "overpunch" character legacy mainframe files use to encode a signed
+// amount's last digit, ASCII-transcoded from the EBCDIC zone convention
+// (positive zone {, A-I; negative zone }, J-R), keyed by digit 0-9.
+var (
+	overpunchPositive = [10]byte{'{', 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I'}
+	overpunchNegative = [10]byte{'}', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R'}
+)
+
+// decodeOverpunchDigit returns the plain digit and sign an overpunch
+// character represents. A plain '0'-'9' is accepted as an unsigned
+// (positive) digit, since not every occurrence of a signed field is
+// actually negative.
+func decodeOverpunchDigit(c byte) (digit byte, negative bool, err error) {
+	if c >= '0' && c <= '9' {
+		return c, false, nil
+	}
+	for d, oc := range overpunchPositive {
+		if oc == c {
+			return byte('0' + d), false, nil
+		}
+	}
+	for d, oc := range overpunchNegative {
+		if oc == c {
+			return byte('0' + d), true, nil
+		}
+	}
+	return 0, false, fmt.Errorf("cnab: %q is not a valid overpunch character", c)
+}
+
+// encodeOverpunchDigit is the inverse of decodeOverpunchDigit.
+func encodeOverpunchDigit(digit byte, negative bool) (byte, error) {
+	if digit < '0' || digit > '9' {
+		return 0, fmt.Errorf("cnab: %q is not a digit", digit)
+	}
+	if negative {
+		return overpunchNegative[digit-'0'], nil
+	}
+	return overpunchPositive[digit-'0'], nil
+}
+
+func init() {
+	// overpunch decodes a zoned-decimal value (its last byte carries the
+	// sign) into a plain digit string with a leading "-" for negative
+	// values, for use as a PreParse transform on legacy mainframe
+	// interest/discount adjustment fields that can go negative.
+	RegisterTransform("overpunch", func(string) (Transform, error) {
+		return func(v string) (string, error) {
+			if v == "" {
+				return v, nil
+			}
+			last := v[len(v)-1]
+			digit, negative, err := decodeOverpunchDigit(last)
+			if err != nil {
+				return "", err
+			}
+			out := v[:len(v)-1] + string(digit)
+			if negative {
+				out = "-" + out
+			}
+			return out, nil
+		}, nil
+	})
+	// overpunch_encode is the PostFormat inverse of overpunch: it takes a
+	// plain digit string with an optional leading "-" and re-encodes the
+	// sign into the last digit's zone, dropping the leading sign
+	// character so the packed value keeps the field's original width.
+	RegisterTransform("overpunch_encode", func(string) (Transform, error) {
+		return func(v string) (string, error) {
+			negative := strings.HasPrefix(v, "-")
+			digits := strings.TrimPrefix(v, "-")
+			digits = strings.TrimPrefix(digits, "+")
+			if digits == "" {
+				return "", fmt.Errorf("cnab: overpunch_encode: empty value")
+			}
+			last := digits[len(digits)-1]
+			encoded, err := encodeOverpunchDigit(last, negative)
+			if err != nil {
+				return "", err
+			}
+			return digits[:len(digits)-1] + string(encoded), nil
+		}, nil
+	})
+	// signed validates that a value is an optionally-signed run of
+	// digits, for FieldSpec.Validators on amount fields that accept a
+	// leading '-' or '+' (e.g. an interest/discount adjustment) rather
+	// than always being unsigned.
+	RegisterValidator("signed", func(string) (Validator, error) {
+		return func(value string) error {
+			v := strings.TrimSpace(value)
+			v = strings.TrimPrefix(v, "-")
+			v = strings.TrimPrefix(v, "+")
+			if v == "" {
+				return fmt.Errorf("value %q is not a signed numeric value", value)
+			}
+			for i := 0; i < len(v); i++ {
+				if v[i] < '0' || v[i] > '9' {
+					return fmt.Errorf("value %q is not a signed numeric value", value)
+				}
+			}
+			return nil
+		}, nil
+	})
+}
@@ -0,0 +1,43 @@
+package cnab
+
+import "testing"
+
+func TestOverpunchPreParseDecodesSign(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "amount", Start: 0, Length: 6, PreParse: []string{"overpunch"}},
+	}}
+	rec, err := ParseRecord(spec, "00012}")
+	if err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+	v, _ := rec.Get("amount")
+	if v != "-000120" {
+		t.Errorf("amount = %q, want -000120", v)
+	}
+}
+
+func TestOverpunchEncodeFoldsSignIntoLastDigit(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "amount", Start: 0, Length: 6, PostFormat: []string{"overpunch_encode"}},
+	}}
+	line, err := PackRecord(spec, map[string]string{"amount": "-00012"})
+	if err != nil {
+		t.Fatalf("PackRecord: %v", err)
+	}
+	if line != "0001K " {
+		t.Fatalf("packed line = %q, want %q (sign folded into the last digit, space-padded to width 6)", line, "0001K ")
+	}
+}
+
+func TestSignedValidatorAcceptsSignAndRejectsGarbage(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "adj", Start: 0, Length: 7, Validators: []string{"signed"}}}}
+	if _, err := ParseRecord(spec, "-000123"); err != nil {
+		t.Errorf("expected -000123 to be accepted: %v", err)
+	}
+	if _, err := ParseRecord(spec, "+000123"); err != nil {
+		t.Errorf("expected +000123 to be accepted: %v", err)
+	}
+	if _, err := ParseRecord(spec, "00012a3"); err == nil {
+		t.Error("expected 00012a3 to be rejected")
+	}
+}
@@ -0,0 +1,48 @@
+package cnab
+
+import "strings"
+
+// PackRecord renders values into a fixed-width line according to spec.
+// A field missing from values falls back to its FieldSpec.Default, or is
+// left blank (space-filled) if no default is set. Values longer than
+// their field's Length are truncated. It is equivalent to calling
+// PackRecordFill with a space filler.
+func PackRecord(spec RecordSpec, values map[string]string) (string, error) {
+	return PackRecordFill(spec, values, ' ')
+}
+
+// PackRecordFill behaves like PackRecord, but pads unfilled field bytes
+// and any byte not covered by a field (gaps between fields, and any
+// trailing bytes up to spec.TotalLength) with fill instead of a space.
+// Some banks require zero-filled numeric layouts ('0') or a custom
+// filler; without this, bytes outside every field's range would come
+// back as whatever the []byte allocation happened to zero-initialize to,
+// which is deterministic in Go (always 0x00) but rarely what a fixed-
+// width consumer expects.
+func PackRecordFill(spec RecordSpec, values map[string]string, fill byte) (string, error) {
+	buf := []byte(strings.Repeat(string(fill), spec.TotalLength()))
+	for _, f := range spec.Fields {
+		v, ok := values[f.Name]
+		if !ok {
+			v = f.Default
+		}
+		if len(f.PostFormat) > 0 {
+			transformed, err := applyTransforms(f.PostFormat, v)
+			if err != nil {
+				return "", err
+			}
+			v = transformed
+		}
+		if err := validateField(f, v); err != nil {
+			return "", err
+		}
+		if len(v) > f.Length {
+			v = v[:f.Length]
+		}
+		copy(buf[f.Start:f.End()], v)
+		for i := f.Start + len(v); i < f.End(); i++ {
+			buf[i] = fill
+		}
+	}
+	return string(buf), nil
+}
@@ -0,0 +1,31 @@
+package cnab
+
+import "testing"
+
+func TestPackRecordDefault(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "kind", Start: 0, Length: 2, Default: "01"},
+		{Name: "name", Start: 2, Length: 5},
+	}}
+	line, err := PackRecord(spec, map[string]string{"name": "BOB"})
+	if err != nil {
+		t.Fatalf("PackRecord: %v", err)
+	}
+	if line != "01BOB  " {
+		t.Errorf("got %q, want %q", line, "01BOB  ")
+	}
+}
+
+func TestPackRecordFillUsesCustomFillerForGapsAndPadding(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "kind", Start: 0, Length: 2, Default: "01"},
+		{Name: "name", Start: 4, Length: 3},
+	}}
+	line, err := PackRecordFill(spec, map[string]string{"name": "BO"}, '0')
+	if err != nil {
+		t.Fatalf("PackRecordFill: %v", err)
+	}
+	if line != "0100BO0" {
+		t.Errorf("got %q, want %q", line, "0100BO0")
+	}
+}
@@ -0,0 +1,172 @@
+package cnab
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// BatchSpec describes one CNAB240-style lote's layout: its own header
+// and trailer records wrapping a shared detail record spec.
+type BatchSpec struct {
+	Header  RecordSpec
+	Detail  RecordSpec
+	Trailer RecordSpec
+
+	// SeqField, if set, names a Detail field renumbered sequentially
+	// (starting at 1) within the batch.
+	SeqField string
+	// AmountField, if set, names the Detail field summed for
+	// TrailerSumField.
+	AmountField string
+	// TrailerCountField, if set, names the Trailer field holding the
+	// number of detail records in the batch.
+	TrailerCountField string
+	// TrailerSumField, if set, names the Trailer field holding the sum
+	// of AmountField across the batch's detail records.
+	TrailerSumField string
+}
+
+// Batch is one lote's structured field values, packed against Spec by
+// PackFile.
+type Batch struct {
+	Spec    BatchSpec
+	Header  map[string]string
+	Details []map[string]string
+	Trailer map[string]string
+}
+
+// File is a whole CNAB240-style file's structured field values: an
+// outer header/trailer pair (per Spec) wrapping one or more batches,
+// packed by PackFile.
+type File struct {
+	Spec    FileSpec
+	Header  map[string]string
+	Batches []Batch
+	Trailer map[string]string
+}
+
+// PackProgressFunc is called after each line PackFile writes, reporting
+// cumulative bytes (each line plus its newline) and records written so
+// far. TotalBytes is always -1: unlike ingest's
+// streaming reader, PackFile's input isn't a sized io.Reader, so there
+// is no byte total to report ahead of actually rendering every line.
+type PackProgressFunc func(processedBytes, totalBytes, records int64)
+
+// PackOption configures optional PackFile behavior.
+type PackOption func(*packConfig)
+
+type packConfig struct {
+	onProgress PackProgressFunc
+}
+
+// WithPackProgress has PackFile report progress to fn as it renders each
+// line, so a long-running batch job can display a progress bar or feed
+// a health check while writing a large file.
+func WithPackProgress(fn PackProgressFunc) PackOption {
+	return func(c *packConfig) { c.onProgress = fn }
+}
+
+// PackFile renders file into its packed lines (one CNAB record per
+// line, newline-terminated), auto-filling each batch's detail sequence
+// numbers and trailer totals the way RemessaBuilder does for a flat
+// file, plus the file trailer's batch count and grand total sum if
+// file.Spec.TrailerCountField/TrailerSumField are set.
+func PackFile(ctx context.Context, file File, opts ...PackOption) (io.Reader, error) {
+	tracer := defaultTracerProvider.Tracer("cnab")
+	_, span := tracer.Start(ctx, "cnab.PackFile")
+	span.SetAttribute("cnab.batch_count", len(file.Batches))
+	defer span.End()
+
+	var cfg packConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	var processedBytes int64
+	var recordsWritten int64
+	reportProgress := func(line string) {
+		processedBytes += int64(len(line)) + 1
+		recordsWritten++
+		if cfg.onProgress != nil {
+			cfg.onProgress(processedBytes, -1, recordsWritten)
+		}
+	}
+
+	var lines []string
+
+	headerLine, err := PackRecord(file.Spec.Header, file.Header)
+	if err != nil {
+		return nil, err
+	}
+	lines = append(lines, headerLine)
+	reportProgress(headerLine)
+
+	totalSum := int64(0)
+	for bi, batch := range file.Batches {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		batchHeaderLine, err := PackRecord(batch.Spec.Header, batch.Header)
+		if err != nil {
+			return nil, &ParseError{Field: batch.Spec.Header.Type, Msg: err.Error(), Line: bi + 1}
+		}
+		lines = append(lines, batchHeaderLine)
+		reportProgress(batchHeaderLine)
+
+		sum := int64(0)
+		for i, values := range batch.Details {
+			values = cloneValues(values)
+			if batch.Spec.SeqField != "" {
+				values[batch.Spec.SeqField] = strconv.Itoa(i + 1)
+			}
+			if batch.Spec.AmountField != "" {
+				v := values[batch.Spec.AmountField]
+				n, err := strconv.ParseInt(v, 10, 64)
+				if err != nil {
+					return nil, &ParseError{Field: batch.Spec.AmountField, Msg: fmt.Sprintf("detail amount %q is not numeric", v), Line: i + 1}
+				}
+				sum += n
+			}
+			line, err := PackRecord(batch.Spec.Detail, values)
+			if err != nil {
+				return nil, &ParseError{Field: batch.Spec.Detail.Type, Msg: err.Error(), Line: i + 1}
+			}
+			lines = append(lines, line)
+			reportProgress(line)
+		}
+		totalSum += sum
+
+		trailer := cloneValues(batch.Trailer)
+		if batch.Spec.TrailerCountField != "" {
+			trailer[batch.Spec.TrailerCountField] = strconv.Itoa(len(batch.Details))
+		}
+		if batch.Spec.TrailerSumField != "" {
+			trailer[batch.Spec.TrailerSumField] = strconv.FormatInt(sum, 10)
+		}
+		batchTrailerLine, err := PackRecord(batch.Spec.Trailer, trailer)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, batchTrailerLine)
+		reportProgress(batchTrailerLine)
+	}
+
+	trailer := cloneValues(file.Trailer)
+	if file.Spec.TrailerCountField != "" {
+		trailer[file.Spec.TrailerCountField] = strconv.Itoa(len(file.Batches))
+	}
+	if file.Spec.TrailerSumField != "" {
+		trailer[file.Spec.TrailerSumField] = strconv.FormatInt(totalSum, 10)
+	}
+	trailerLine, err := PackRecord(file.Spec.Trailer, trailer)
+	if err != nil {
+		return nil, err
+	}
+	lines = append(lines, trailerLine)
+	reportProgress(trailerLine)
+
+	return strings.NewReader(strings.Join(lines, "\n") + "\n"), nil
+}
@@ -0,0 +1,146 @@
+package cnab
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestPackFileFillsBatchAndFileTotals(t *testing.T) {
+	fileSpec := FileSpec{
+		Header:            RecordSpec{Fields: []FieldSpec{{Name: "tipo", Start: 0, Length: 1, Default: "0"}}},
+		Trailer:           RecordSpec{Fields: []FieldSpec{{Name: "lotes", Start: 0, Length: 3, PostFormat: []string{"zerofill:3"}}}},
+		TrailerCountField: "lotes",
+	}
+	batchSpec := BatchSpec{
+		Header: RecordSpec{Fields: []FieldSpec{{Name: "tipo", Start: 0, Length: 1, Default: "1"}}},
+		Detail: RecordSpec{Fields: []FieldSpec{
+			{Name: "seq", Start: 0, Length: 2, PostFormat: []string{"zerofill:2"}},
+			{Name: "amount", Start: 2, Length: 4, PostFormat: []string{"zerofill:4"}},
+		}},
+		Trailer: RecordSpec{Fields: []FieldSpec{
+			{Name: "count", Start: 0, Length: 3, PostFormat: []string{"zerofill:3"}},
+			{Name: "sum", Start: 3, Length: 6, PostFormat: []string{"zerofill:6"}},
+		}},
+		SeqField:          "seq",
+		AmountField:       "amount",
+		TrailerCountField: "count",
+		TrailerSumField:   "sum",
+	}
+
+	file := File{
+		Spec:   fileSpec,
+		Header: map[string]string{},
+		Batches: []Batch{
+			{
+				Spec: batchSpec,
+				Details: []map[string]string{
+					{"amount": "0010"},
+					{"amount": "0020"},
+				},
+			},
+		},
+		Trailer: map[string]string{},
+	}
+
+	r, err := PackFile(context.Background(), file)
+	if err != nil {
+		t.Fatalf("PackFile: %v", err)
+	}
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	lines := strings.Split(strings.TrimRight(string(out), "\n"), "\n")
+	if len(lines) != 6 {
+		t.Fatalf("got %d lines, want 6 (file header, batch header, 2 details, batch trailer, file trailer): %v", len(lines), lines)
+	}
+	if lines[0] != "0" {
+		t.Errorf("file header = %q, want %q", lines[0], "0")
+	}
+	if lines[1] != "1" {
+		t.Errorf("batch header = %q, want %q", lines[1], "1")
+	}
+	if lines[2] != "010010" || lines[3] != "020020" {
+		t.Errorf("detail lines = %q, %q, want seq numbers 01/02 filled in", lines[2], lines[3])
+	}
+	if lines[4] != "002000030" {
+		t.Errorf("batch trailer = %q, want count=002 sum=000030", lines[4])
+	}
+	if lines[5] != "001" {
+		t.Errorf("file trailer = %q, want batch count 001", lines[5])
+	}
+}
+
+func TestPackFileReportsProgress(t *testing.T) {
+	fileSpec := FileSpec{
+		Header:  RecordSpec{Fields: []FieldSpec{{Name: "tipo", Start: 0, Length: 1, Default: "0"}}},
+		Trailer: RecordSpec{Fields: []FieldSpec{{Name: "tipo", Start: 0, Length: 1, Default: "9"}}},
+	}
+	batchSpec := BatchSpec{
+		Header:  RecordSpec{Fields: []FieldSpec{{Name: "tipo", Start: 0, Length: 1, Default: "1"}}},
+		Detail:  RecordSpec{Fields: []FieldSpec{{Name: "amount", Start: 0, Length: 4, PostFormat: []string{"zerofill:4"}}}},
+		Trailer: RecordSpec{Fields: []FieldSpec{{Name: "tipo", Start: 0, Length: 1, Default: "5"}}},
+	}
+	file := File{
+		Spec:   fileSpec,
+		Header: map[string]string{},
+		Batches: []Batch{
+			{Spec: batchSpec, Details: []map[string]string{{"amount": "10"}, {"amount": "20"}}},
+		},
+		Trailer: map[string]string{},
+	}
+
+	var calls int
+	var lastRecords int64
+	var lastTotalBytes int64
+	_, err := PackFile(context.Background(), file, WithPackProgress(func(processedBytes, totalBytes, records int64) {
+		calls++
+		lastRecords = records
+		lastTotalBytes = totalBytes
+	}))
+	if err != nil {
+		t.Fatalf("PackFile: %v", err)
+	}
+	if calls != 6 {
+		t.Fatalf("got %d progress calls, want 6 (one per line)", calls)
+	}
+	if lastRecords != 6 {
+		t.Errorf("final records = %d, want 6", lastRecords)
+	}
+	if lastTotalBytes != -1 {
+		t.Errorf("totalBytes = %d, want -1 (unknown for an in-memory File)", lastTotalBytes)
+	}
+}
+
+func TestPackFileRejectsNonNumericAmount(t *testing.T) {
+	fileSpec := FileSpec{
+		Header:  RecordSpec{Fields: []FieldSpec{{Name: "tipo", Start: 0, Length: 1, Default: "0"}}},
+		Trailer: RecordSpec{Fields: []FieldSpec{{Name: "tipo", Start: 0, Length: 1, Default: "9"}}},
+	}
+	batchSpec := BatchSpec{
+		Header:      RecordSpec{Fields: []FieldSpec{{Name: "tipo", Start: 0, Length: 1, Default: "1"}}},
+		Detail:      RecordSpec{Fields: []FieldSpec{{Name: "amount", Start: 0, Length: 4}}},
+		Trailer:     RecordSpec{Fields: []FieldSpec{{Name: "tipo", Start: 0, Length: 1, Default: "5"}}},
+		AmountField: "amount",
+	}
+	file := File{
+		Spec:   fileSpec,
+		Header: map[string]string{},
+		Batches: []Batch{
+			{Spec: batchSpec, Details: []map[string]string{{"amount": "abcd"}}},
+		},
+		Trailer: map[string]string{},
+	}
+
+	_, err := PackFile(context.Background(), file)
+	if err == nil {
+		t.Fatal("expected an error for a non-numeric amount, not a silently wrong trailer sum")
+	}
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf("got %T, want *ParseError", err)
+	}
+}
@@ -0,0 +1,89 @@
+package cnab
+
+// ParseRecord slices line according to spec and returns the fields in
+// declaration order. line must be at least as long as the highest field
+// end offset in spec.
+func ParseRecord(spec RecordSpec, line string) (*Record, error) {
+	return ParseRecordAt(spec, line, 0)
+}
+
+// ParseRecordAt behaves like ParseRecord, but stamps lineNumber onto any
+// returned *ParseError so callers processing a whole file can report
+// exactly where a failure occurred. lineNumber is 1-based; pass 0 when it
+// is not known or not applicable.
+func ParseRecordAt(spec RecordSpec, line string, lineNumber int) (*Record, error) {
+	rec := NewRecord()
+	if err := ParseRecordInto(spec, line, lineNumber, rec); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// ParseRecordPreserveRaw behaves like ParseRecordAt, but additionally
+// stamps each field's original, untransformed substring onto the
+// returned Record, retrievable via Record.Raw. Use this when downstream
+// systems (audit, reconciliation) must store exactly what the bank sent,
+// independent of PreParse transforms.
+func ParseRecordPreserveRaw(spec RecordSpec, line string, lineNumber int) (*Record, error) {
+	rec := NewRecord()
+	if err := parseRecordInto(spec, line, lineNumber, rec, true); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// ParseRecordInto parses line into rec, reusing rec's backing storage via
+// Reset instead of allocating a new Record. It is the fast path for
+// callers parsing many lines against the same spec (e.g. in a tight loop
+// or a pooled worker), where avoiding a Record allocation per line
+// matters.
+func ParseRecordInto(spec RecordSpec, line string, lineNumber int, rec *Record) error {
+	return parseRecordInto(spec, line, lineNumber, rec, false)
+}
+
+func parseRecordInto(spec RecordSpec, line string, lineNumber int, rec *Record, preserveRaw bool) error {
+	rec.Reset()
+	for _, f := range spec.Fields {
+		if f.End() > len(line) {
+			return &ParseError{Field: f.Name, Msg: "field extends beyond end of line", Line: lineNumber, Start: f.Start, End: f.End(), Code: CodeFieldOutOfBounds}
+		}
+		value := line[f.Start:f.End()]
+		if preserveRaw {
+			rec.setRaw(f.Name, value)
+		}
+		if len(f.PreParse) > 0 {
+			transformed, err := applyTransforms(f.PreParse, value)
+			if err != nil {
+				return &ParseError{Field: f.Name, Msg: err.Error(), Line: lineNumber, Start: f.Start, End: f.End()}
+			}
+			value = transformed
+		}
+		if err := validateField(f, value); err != nil {
+			if pe, ok := err.(*ParseError); ok {
+				pe.Line = lineNumber
+				pe.Start, pe.End = f.Start, f.End()
+			}
+			return err
+		}
+		rec.Set(f.Name, value)
+	}
+
+	for _, g := range spec.Groups {
+		if g.End() > len(line) {
+			return &ParseError{Field: g.Name, Msg: "group extends beyond end of line", Line: lineNumber, Start: g.Start, End: g.End(), Code: CodeFieldOutOfBounds}
+		}
+		itemSpec := g.itemSpec()
+		occurrences := make([]*Record, g.Occurs)
+		for i := 0; i < g.Occurs; i++ {
+			itemStart := g.Start + i*g.ItemLength
+			item := line[itemStart : itemStart+g.ItemLength]
+			occRec, err := ParseRecordAt(itemSpec, item, lineNumber)
+			if err != nil {
+				return err
+			}
+			occurrences[i] = occRec
+		}
+		rec.SetGroup(g.Name, occurrences)
+	}
+	return nil
+}
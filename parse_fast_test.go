@@ -0,0 +1,37 @@
+package cnab
+
+import "testing"
+
+func TestParseRecordIntoReuse(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "name", Start: 0, Length: 5}}}
+	rec := NewRecord()
+
+	if err := ParseRecordInto(spec, "ALICE", 1, rec); err != nil {
+		t.Fatalf("ParseRecordInto: %v", err)
+	}
+	if v, _ := rec.Get("name"); v != "ALICE" {
+		t.Fatalf("name = %q, want ALICE", v)
+	}
+
+	if err := ParseRecordInto(spec, "BOB  ", 2, rec); err != nil {
+		t.Fatalf("ParseRecordInto: %v", err)
+	}
+	if v, _ := rec.Get("name"); v != "BOB  " {
+		t.Fatalf("name = %q, want %q", v, "BOB  ")
+	}
+	if len(rec.Fields()) != 1 {
+		t.Fatalf("expected fields to be reset, got %d", len(rec.Fields()))
+	}
+}
+
+func BenchmarkParseRecordInto(b *testing.B) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "agency", Start: 0, Length: 4},
+		{Name: "name", Start: 4, Length: 5},
+	}}
+	rec := NewRecord()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = ParseRecordInto(spec, "0001ALICE", 0, rec)
+	}
+}
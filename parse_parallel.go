@@ -0,0 +1,44 @@
+package cnab
+
+import (
+	"runtime"
+	"sync"
+)
+
+// ParseLinesParallel parses each line against spec concurrently, using up
+// to runtime.GOMAXPROCS(0) workers, and returns results in the same order
+// as lines. It is intended for large files where per-line parsing cost
+// dominates.
+func ParseLinesParallel(spec RecordSpec, lines []string) ([]*Record, []error) {
+	records := make([]*Record, len(lines))
+	errs := make([]error, len(lines))
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(lines) {
+		workers = len(lines)
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				rec, err := ParseRecordAt(spec, lines[i], i+1)
+				records[i] = rec
+				errs[i] = err
+			}
+		}()
+	}
+	for i := range lines {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return records, errs
+}
@@ -0,0 +1,22 @@
+package cnab
+
+import "testing"
+
+func TestParseLinesParallelPreservesOrder(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "n", Start: 0, Length: 2}}}
+	lines := make([]string, 200)
+	for i := range lines {
+		b := [2]byte{byte('0' + (i/10)%10), byte('0' + i%10)}
+		lines[i] = string(b[:])
+	}
+	records, errs := ParseLinesParallel(spec, lines)
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("line %d: %v", i, err)
+		}
+		v, _ := records[i].Get("n")
+		if v != lines[i] {
+			t.Errorf("line %d: got %q, want %q", i, v, lines[i])
+		}
+	}
+}
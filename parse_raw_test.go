@@ -0,0 +1,30 @@
+package cnab
+
+import "testing"
+
+func TestParseRecordPreserveRaw(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "name", Start: 0, Length: 10, PreParse: []string{"trim"}},
+	}}
+	rec, err := ParseRecordPreserveRaw(spec, "JOHN      ", 1)
+	if err != nil {
+		t.Fatalf("ParseRecordPreserveRaw: %v", err)
+	}
+	if v, _ := rec.Get("name"); v != "JOHN" {
+		t.Errorf("Get(name) = %q, want trimmed %q", v, "JOHN")
+	}
+	if raw, ok := rec.Raw("name"); !ok || raw != "JOHN      " {
+		t.Errorf("Raw(name) = %q, %v, want untrimmed value", raw, ok)
+	}
+}
+
+func TestRecordRawAbsentWithoutPreservation(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "name", Start: 0, Length: 4}}}
+	rec, err := ParseRecord(spec, "ABCD")
+	if err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+	if _, ok := rec.Raw("name"); ok {
+		t.Error("expected Raw to be absent for a record parsed without preservation")
+	}
+}
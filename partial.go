@@ -0,0 +1,35 @@
+package cnab
+
+// ParseRecordFields parses only the named subset of spec's fields from
+// line, skipping the rest. It is useful for cheaply inspecting a few
+// fields (e.g. a record-type discriminator) without paying the cost, or
+// risking the validation errors, of a full ParseRecord.
+func ParseRecordFields(spec RecordSpec, line string, names []string) (*Record, error) {
+	want := make(map[string]bool, len(names))
+	for _, n := range names {
+		want[n] = true
+	}
+
+	rec := NewRecord()
+	for _, f := range spec.Fields {
+		if !want[f.Name] {
+			continue
+		}
+		if f.End() > len(line) {
+			return nil, &ParseError{Field: f.Name, Msg: "field extends beyond end of line", Start: f.Start, End: f.End()}
+		}
+		value := line[f.Start:f.End()]
+		if len(f.PreParse) > 0 {
+			transformed, err := applyTransforms(f.PreParse, value)
+			if err != nil {
+				return nil, &ParseError{Field: f.Name, Msg: err.Error(), Start: f.Start, End: f.End()}
+			}
+			value = transformed
+		}
+		if err := validateField(f, value); err != nil {
+			return nil, err
+		}
+		rec.Set(f.Name, value)
+	}
+	return rec, nil
+}
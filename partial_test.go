@@ -0,0 +1,21 @@
+package cnab
+
+import "testing"
+
+func TestParseRecordFields(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "type", Start: 0, Length: 1},
+		{Name: "agency", Start: 1, Length: 4, Validators: []string{"required"}},
+		{Name: "name", Start: 5, Length: 5},
+	}}
+	rec, err := ParseRecordFields(spec, "H", []string{"type"})
+	if err != nil {
+		t.Fatalf("ParseRecordFields: %v", err)
+	}
+	if len(rec.Fields()) != 1 {
+		t.Fatalf("got %d fields, want 1", len(rec.Fields()))
+	}
+	if v, _ := rec.Get("type"); v != "H" {
+		t.Errorf("type = %q, want H", v)
+	}
+}
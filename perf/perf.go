@@ -0,0 +1,82 @@
+// Package perf compares benchmark results against a stored baseline, so a
+// contributor's change can be flagged as a performance regression before
+// it ever reaches a reviewer, independent of the noisy absolute numbers
+// `go test -bench` prints on any given machine.
+package perf
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Result is one named benchmark's measured cost, in the same units
+// testing.BenchmarkResult reports (nanoseconds per op, bytes allocated
+// per op, allocations per op).
+type Result struct {
+	Name        string  `json:"name"`
+	NsPerOp     float64 `json:"ns_per_op"`
+	BytesPerOp  float64 `json:"bytes_per_op"`
+	AllocsPerOp float64 `json:"allocs_per_op"`
+}
+
+// Baseline is a named set of Results, keyed by benchmark name, as loaded
+// from a JSON file checked into the repository.
+type Baseline map[string]Result
+
+// LoadBaseline reads a Baseline previously written by WriteBaseline.
+func LoadBaseline(r io.Reader) (Baseline, error) {
+	var results []Result
+	if err := json.NewDecoder(r).Decode(&results); err != nil {
+		return nil, fmt.Errorf("perf: decode baseline: %w", err)
+	}
+	b := make(Baseline, len(results))
+	for _, res := range results {
+		b[res.Name] = res
+	}
+	return b, nil
+}
+
+// WriteBaseline serializes results to w in the format LoadBaseline
+// expects, sorted by name for a stable diff.
+func WriteBaseline(w io.Writer, results []Result) error {
+	sorted := append([]Result(nil), results...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(sorted)
+}
+
+// Regression describes a benchmark whose current cost exceeds its
+// baseline by more than the configured tolerance.
+type Regression struct {
+	Name     string
+	Baseline float64
+	Current  float64
+	Percent  float64
+}
+
+// Compare reports every benchmark in current whose ns/op exceeds its
+// counterpart in baseline by more than tolerance (e.g. 0.20 for 20%). A
+// benchmark present in current but absent from baseline is skipped, not
+// flagged, since it has nothing to regress against.
+func Compare(baseline Baseline, current []Result, tolerance float64) []Regression {
+	var regressions []Regression
+	for _, cur := range current {
+		base, ok := baseline[cur.Name]
+		if !ok || base.NsPerOp <= 0 {
+			continue
+		}
+		percent := (cur.NsPerOp - base.NsPerOp) / base.NsPerOp
+		if percent > tolerance {
+			regressions = append(regressions, Regression{
+				Name:     cur.Name,
+				Baseline: base.NsPerOp,
+				Current:  cur.NsPerOp,
+				Percent:  percent,
+			})
+		}
+	}
+	return regressions
+}
@@ -0,0 +1,46 @@
+package perf
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteAndLoadBaselineRoundTrip(t *testing.T) {
+	results := []Result{
+		{Name: "BenchmarkParseRecord/5fields", NsPerOp: 100, BytesPerOp: 32, AllocsPerOp: 1},
+		{Name: "BenchmarkPackRecord/5fields", NsPerOp: 150, BytesPerOp: 48, AllocsPerOp: 2},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteBaseline(&buf, results); err != nil {
+		t.Fatalf("WriteBaseline: %v", err)
+	}
+
+	baseline, err := LoadBaseline(&buf)
+	if err != nil {
+		t.Fatalf("LoadBaseline: %v", err)
+	}
+	if len(baseline) != 2 {
+		t.Fatalf("got %d entries, want 2", len(baseline))
+	}
+	if baseline["BenchmarkParseRecord/5fields"].NsPerOp != 100 {
+		t.Errorf("NsPerOp = %v, want 100", baseline["BenchmarkParseRecord/5fields"].NsPerOp)
+	}
+}
+
+func TestCompareFlagsRegressionsOverTolerance(t *testing.T) {
+	baseline := Baseline{
+		"BenchmarkParseRecord": {Name: "BenchmarkParseRecord", NsPerOp: 100},
+		"BenchmarkPackRecord":  {Name: "BenchmarkPackRecord", NsPerOp: 100},
+	}
+	current := []Result{
+		{Name: "BenchmarkParseRecord", NsPerOp: 130}, // +30%, over a 20% tolerance
+		{Name: "BenchmarkPackRecord", NsPerOp: 105},  // +5%, within tolerance
+		{Name: "BenchmarkNewThing", NsPerOp: 9999},   // no baseline entry, skipped
+	}
+
+	regressions := Compare(baseline, current, 0.20)
+	if len(regressions) != 1 || regressions[0].Name != "BenchmarkParseRecord" {
+		t.Fatalf("regressions = %+v, want exactly BenchmarkParseRecord flagged", regressions)
+	}
+}
@@ -0,0 +1,58 @@
+package cnab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParsePicture parses a COBOL picture clause such as "9(13)V9(2)" or
+// "9(15)" into the fixed-width length and implied decimal digit count it
+// describes: "9" (or "9(n)") is a run of n digits, and "V" marks the
+// (unstored) position of the decimal point separating the integer part
+// from the decimal part. A clause with no "V" has zero decimals.
+func ParsePicture(pic string) (length, decimals int, err error) {
+	intPart, decPart, hasV := strings.Cut(pic, "V")
+	intLen, err := pictureDigitCount(intPart)
+	if err != nil {
+		return 0, 0, fmt.Errorf("cnab: invalid picture %q: %w", pic, err)
+	}
+	if !hasV {
+		return intLen, 0, nil
+	}
+	decLen, err := pictureDigitCount(decPart)
+	if err != nil {
+		return 0, 0, fmt.Errorf("cnab: invalid picture %q: %w", pic, err)
+	}
+	return intLen + decLen, decLen, nil
+}
+
+// pictureDigitCount sums the digit counts of a run of "9" and "9(n)"
+// clauses, e.g. "9(3)9" is 4.
+func pictureDigitCount(part string) (int, error) {
+	total := 0
+	for len(part) > 0 {
+		if part[0] != '9' {
+			return 0, fmt.Errorf("unsupported picture symbol %q", part[0])
+		}
+		part = part[1:]
+		if strings.HasPrefix(part, "(") {
+			close := strings.IndexByte(part, ')')
+			if close < 0 {
+				return 0, fmt.Errorf("unterminated repeat count")
+			}
+			n, err := strconv.Atoi(part[1:close])
+			if err != nil {
+				return 0, fmt.Errorf("invalid repeat count %q: %w", part[1:close], err)
+			}
+			if n <= 0 {
+				return 0, fmt.Errorf("repeat count %q must be positive", part[1:close])
+			}
+			total += n
+			part = part[close+1:]
+			continue
+		}
+		total++
+	}
+	return total, nil
+}
@@ -0,0 +1,58 @@
+package cnab
+
+import "testing"
+
+func TestParsePicture(t *testing.T) {
+	cases := []struct {
+		pic         string
+		wantLength  int
+		wantDecimal int
+	}{
+		{"9(13)V9(2)", 15, 2},
+		{"9(15)", 15, 0},
+		{"999", 3, 0},
+		{"9(3)V99", 5, 2},
+	}
+	for _, c := range cases {
+		length, decimals, err := ParsePicture(c.pic)
+		if err != nil {
+			t.Errorf("ParsePicture(%q): %v", c.pic, err)
+			continue
+		}
+		if length != c.wantLength || decimals != c.wantDecimal {
+			t.Errorf("ParsePicture(%q) = (%d, %d), want (%d, %d)", c.pic, length, decimals, c.wantLength, c.wantDecimal)
+		}
+	}
+}
+
+func TestParsePictureInvalid(t *testing.T) {
+	if _, _, err := ParsePicture("X(5)"); err == nil {
+		t.Error("expected an error for unsupported symbol X")
+	}
+	if _, _, err := ParsePicture("9(5"); err == nil {
+		t.Error("expected an error for unterminated repeat count")
+	}
+	if _, _, err := ParsePicture("9(0)"); err == nil {
+		t.Error("expected an error for a zero repeat count")
+	}
+	if _, _, err := ParsePicture("9(-5)"); err == nil {
+		t.Error("expected an error for a negative repeat count")
+	}
+}
+
+func TestResolveSpecDerivesLengthFromPicture(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "amount", Start: 0, Picture: "9(13)V9(2)"},
+	}}
+	resolved, err := ResolveSpec(spec)
+	if err != nil {
+		t.Fatalf("ResolveSpec: %v", err)
+	}
+	f := resolved.Fields[0]
+	if f.Length != 15 || f.Decimals != 2 {
+		t.Errorf("got Length=%d Decimals=%d, want 15, 2", f.Length, f.Decimals)
+	}
+	if f.Picture != "" {
+		t.Errorf("Picture = %q, want cleared after resolution, matching Pos", f.Picture)
+	}
+}
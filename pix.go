@@ -0,0 +1,41 @@
+package cnab
+
+// PIX segment specs for CNAB240 boleto híbrido files, as introduced by
+// FEBRABAN for boletos payable via PIX. Segment J-52 carries the PIX
+// identifiers attached to a título; segment J-99 carries free-form
+// complementary data for the same purpose. Both are 240-byte records.
+
+// SegmentJ52Spec describes the PIX segment J-52 (qr code / txid) fields.
+func SegmentJ52Spec() RecordSpec {
+	return RecordSpec{
+		Type: "segmentJ52",
+		Fields: []FieldSpec{
+			Field1Based("bankCode", 1, 3),
+			Field1Based("batchNumber", 4, 4),
+			Field1Based("recordType", 8, 1),
+			Field1Based("recordSeq", 9, 5),
+			Field1Based("segmentCode", 14, 1),
+			Field1Based("movementCode", 15, 2),
+			Field1Based("pixTxid", 18, 35),
+			Field1Based("pixEndToEndId", 53, 32),
+			Field1Based("filler", 85, 156),
+		},
+	}
+}
+
+// SegmentJ99Spec describes the PIX segment J-99 (complementary QR code
+// payload) fields.
+func SegmentJ99Spec() RecordSpec {
+	return RecordSpec{
+		Type: "segmentJ99",
+		Fields: []FieldSpec{
+			Field1Based("bankCode", 1, 3),
+			Field1Based("batchNumber", 4, 4),
+			Field1Based("recordType", 8, 1),
+			Field1Based("recordSeq", 9, 5),
+			Field1Based("segmentCode", 14, 1),
+			Field1Based("qrCodePayload", 18, 99),
+			Field1Based("filler", 117, 124),
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package cnab
+
+import "testing"
+
+func TestSegmentJ52SpecValid(t *testing.T) {
+	spec := SegmentJ52Spec()
+	if err := ValidateSpec(spec); err != nil {
+		t.Fatalf("ValidateSpec: %v", err)
+	}
+	width := 0
+	for _, f := range spec.Fields {
+		if f.End() > width {
+			width = f.End()
+		}
+	}
+	if width != 240 {
+		t.Errorf("got width %d, want 240", width)
+	}
+}
+
+func TestSegmentJ99SpecValid(t *testing.T) {
+	spec := SegmentJ99Spec()
+	if err := ValidateSpec(spec); err != nil {
+		t.Fatalf("ValidateSpec: %v", err)
+	}
+	width := 0
+	for _, f := range spec.Fields {
+		if f.End() > width {
+			width = f.End()
+		}
+	}
+	if width != 240 {
+		t.Errorf("got width %d, want 240", width)
+	}
+}
@@ -0,0 +1,32 @@
+package cnab
+
+import "sync"
+
+// RecordPool reuses *Record values across ParseRecordInto calls, avoiding
+// an allocation per record for callers that process records one at a
+// time (e.g. a streaming reader) and return them to the pool once done.
+type RecordPool struct {
+	pool sync.Pool
+}
+
+// NewRecordPool creates an empty RecordPool.
+func NewRecordPool() *RecordPool {
+	return &RecordPool{pool: sync.Pool{New: func() interface{} { return NewRecord() }}}
+}
+
+// Get returns a *Record ready for use, either freshly allocated or reused
+// from the pool. Its fields are already reset (empty).
+func (p *RecordPool) Get() *Record {
+	rec := p.pool.Get().(*Record)
+	rec.Reset()
+	return rec
+}
+
+// Put returns rec to the pool for reuse. Callers must not use rec after
+// calling Put.
+func (p *RecordPool) Put(rec *Record) {
+	if rec == nil {
+		return
+	}
+	p.pool.Put(rec)
+}
@@ -0,0 +1,19 @@
+package cnab
+
+import "testing"
+
+func TestRecordPoolReuse(t *testing.T) {
+	pool := NewRecordPool()
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "name", Start: 0, Length: 5}}}
+
+	rec := pool.Get()
+	if err := ParseRecordInto(spec, "ALICE", 0, rec); err != nil {
+		t.Fatalf("ParseRecordInto: %v", err)
+	}
+	pool.Put(rec)
+
+	rec2 := pool.Get()
+	if len(rec2.Fields()) != 0 {
+		t.Fatalf("expected reset record from pool, got %+v", rec2.Fields())
+	}
+}
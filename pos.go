@@ -0,0 +1,54 @@
+package cnab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParsePosRange parses a 1-based inclusive range such as "004..007" into a
+// zero-based Start and a Length, as used by FieldSpec.Start/Length.
+func ParsePosRange(pos string) (start, length int, err error) {
+	from, to, ok := strings.Cut(pos, "..")
+	if !ok {
+		return 0, 0, fmt.Errorf("cnab: invalid pos %q: expected \"from..to\"", pos)
+	}
+	a, err := strconv.Atoi(strings.TrimSpace(from))
+	if err != nil {
+		return 0, 0, fmt.Errorf("cnab: invalid pos %q: %w", pos, err)
+	}
+	b, err := strconv.Atoi(strings.TrimSpace(to))
+	if err != nil {
+		return 0, 0, fmt.Errorf("cnab: invalid pos %q: %w", pos, err)
+	}
+	if a < 1 || b < a {
+		return 0, 0, fmt.Errorf("cnab: invalid pos %q: range out of bounds", pos)
+	}
+	return a - 1, b - a + 1, nil
+}
+
+// ResolveSpec returns a copy of spec with every field's Pos range (if set)
+// converted to Start/Length.
+func ResolveSpec(spec RecordSpec) (RecordSpec, error) {
+	resolved := RecordSpec{Type: spec.Type, Fields: make([]FieldSpec, len(spec.Fields)), Groups: spec.Groups}
+	for i, f := range spec.Fields {
+		if f.Pos != "" {
+			start, length, err := ParsePosRange(f.Pos)
+			if err != nil {
+				return RecordSpec{}, fmt.Errorf("field %q: %w", f.Name, err)
+			}
+			f.Start, f.Length = start, length
+			f.Pos = ""
+		}
+		if f.Picture != "" && f.Length == 0 {
+			length, decimals, err := ParsePicture(f.Picture)
+			if err != nil {
+				return RecordSpec{}, fmt.Errorf("field %q: %w", f.Name, err)
+			}
+			f.Length, f.Decimals = length, decimals
+			f.Picture = ""
+		}
+		resolved.Fields[i] = f
+	}
+	return resolved, nil
+}
@@ -0,0 +1,47 @@
+package cnab
+
+import "testing"
+
+func TestParsePosRange(t *testing.T) {
+	start, length, err := ParsePosRange("004..007")
+	if err != nil {
+		t.Fatalf("ParsePosRange: %v", err)
+	}
+	if start != 3 || length != 4 {
+		t.Errorf("got start=%d length=%d, want start=3 length=4", start, length)
+	}
+}
+
+func TestResolveSpec(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "agency", Pos: "001..004"}}}
+	resolved, err := ResolveSpec(spec)
+	if err != nil {
+		t.Fatalf("ResolveSpec: %v", err)
+	}
+	f := resolved.Fields[0]
+	if f.Start != 0 || f.Length != 4 {
+		t.Errorf("got start=%d length=%d, want start=0 length=4", f.Start, f.Length)
+	}
+
+	rec, err := ParseRecord(resolved, "0001")
+	if err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+	if v, _ := rec.Get("agency"); v != "0001" {
+		t.Errorf("agency = %q, want 0001", v)
+	}
+}
+
+func TestResolveSpecPreservesGroups(t *testing.T) {
+	spec := RecordSpec{
+		Fields: []FieldSpec{{Name: "agency", Pos: "001..004"}},
+		Groups: []GroupSpec{{Name: "items", Start: 4, ItemLength: 2, Occurs: 3}},
+	}
+	resolved, err := ResolveSpec(spec)
+	if err != nil {
+		t.Fatalf("ResolveSpec: %v", err)
+	}
+	if len(resolved.Groups) != 1 || resolved.Groups[0].Name != "items" {
+		t.Errorf("Groups = %+v, want the original Groups preserved", resolved.Groups)
+	}
+}
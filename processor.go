@@ -0,0 +1,456 @@
+package cnab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// discardLogger is the default logger for a Processor created without
+// WithLogger: it emits nothing.
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// encodingEBCDIC is the WithEncoding value selecting IBM037 EBCDIC
+// transcoding of lines passed to/from the Processor.
+const encodingEBCDIC = "ebcdic"
+
+// Processor wraps a RecordSpec with cross-cutting concerns (metrics,
+// tracing, logging, ...) configured via functional options, so that
+// callers who need those concerns don't have to thread them through
+// every ParseRecord/PackRecord call by hand.
+type Processor struct {
+	spec        RecordSpec
+	metrics     Metrics
+	tracer      Tracer
+	logger      *slog.Logger
+	mask        bool
+	handlers    *FieldHandlerStore
+	encoding    string
+	strict      bool
+	laxTrailing bool
+	fill        byte
+	coercion    CoercionPolicy
+	loc         *time.Location
+	locale      string
+	specsMu     sync.RWMutex
+	specs       map[string]RecordSpec
+}
+
+// Option configures a Processor constructed by NewProcessor.
+type Option func(*Processor)
+
+// NewProcessor returns a Processor for spec with the given options
+// applied. Without WithMetrics, metrics calls are routed to NopMetrics.
+// Without WithTracerProvider, tracing uses the package-level default (see
+// SetTracerProvider), itself a no-op until configured. Without
+// WithLogger, log calls are discarded.
+func NewProcessor(spec RecordSpec, opts ...Option) *Processor {
+	p := &Processor{
+		spec:    spec,
+		metrics: NopMetrics{},
+		tracer:  defaultTracerProvider.Tracer("cnab"),
+		logger:  discardLogger,
+		fill:    ' ',
+		loc:     time.UTC,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// WithMetrics sets the Metrics sink used to instrument parse/pack
+// operations performed through the Processor.
+func WithMetrics(m Metrics) Option {
+	return func(p *Processor) { p.metrics = m }
+}
+
+// WithTracerProvider sets the TracerProvider used to emit spans for
+// parse/pack operations performed through the Processor, overriding the
+// package-level default installed via SetTracerProvider.
+func WithTracerProvider(tp TracerProvider) Option {
+	return func(p *Processor) { p.tracer = tp.Tracer("cnab") }
+}
+
+// WithLogger sets the logger used to emit debug/warn records (parse
+// failures, skipped records) for operations performed through the
+// Processor, in place of the default which discards everything.
+func WithLogger(logger *slog.Logger) Option {
+	return func(p *Processor) { p.logger = logger }
+}
+
+// WithSpec overrides the RecordSpec a Processor was constructed with,
+// for callers that build a Processor before its spec is fully resolved
+// (e.g. one fetched asynchronously via specsource) and want to finalize
+// it alongside the rest of the option list rather than reassigning
+// afterward.
+func WithSpec(spec RecordSpec) Option {
+	return func(p *Processor) { p.spec = spec }
+}
+
+// WithFieldHandlerStore installs per-field FieldHandler overrides, for
+// fields whose parse/format logic doesn't fit the Validators/PreParse/
+// PostFormat rule chain.
+func WithFieldHandlerStore(store *FieldHandlerStore) Option {
+	return func(p *Processor) { p.handlers = store }
+}
+
+// WithEncoding sets the on-the-wire byte encoding of lines passed to
+// ParseRecord/ParseRecordContext and produced by PackRecord/
+// PackRecordContext. The only recognized non-default value is "ebcdic"
+// (IBM037), for legacy mainframe-generated files; the default "" leaves
+// bytes untouched (ASCII/UTF-8).
+func WithEncoding(encoding string) Option {
+	return func(p *Processor) { p.encoding = encoding }
+}
+
+// WithStrictMode makes PackRecordContext reject a value longer than its
+// field's Length instead of silently truncating it, the default
+// PackRecord behavior. Use this when silent truncation would corrupt a
+// downstream reconciliation rather than merely losing padding.
+func WithStrictMode(enabled bool) Option {
+	return func(p *Processor) { p.strict = enabled }
+}
+
+// WithLaxTrailingPadding makes ParseRecordContext pad a short line with
+// trailing spaces up to the spec's declared length before parsing,
+// instead of failing once a field extends beyond the end of the line.
+// Some banks strip trailing whitespace when the last fields on a record
+// are blank, which otherwise looks identical to truncation; a field that
+// is genuinely missing rather than merely blank still fails normally,
+// since padding only supplies spaces and a Required or non-blank
+// Validators rule on that field rejects an all-space value the same way
+// it would reject any other blank one.
+func WithLaxTrailingPadding(enabled bool) Option {
+	return func(p *Processor) { p.laxTrailing = enabled }
+}
+
+// WithFillChar sets the byte PackRecordContext uses to pad unfilled field
+// bytes and any byte not covered by a field, in place of the default
+// space. Pass '0' for layouts that zero-fill numeric records.
+func WithFillChar(fill byte) Option {
+	return func(p *Processor) { p.fill = fill }
+}
+
+// WithCoercion sets the CoercionPolicy used by CoerceInt and CoerceFloat,
+// making explicit and configurable a decision (whether a string with
+// surrounding whitespace or a fractional part still counts as an
+// integer) that used to differ silently between call sites.
+func WithCoercion(policy CoercionPolicy) Option {
+	return func(p *Processor) { p.coercion = policy }
+}
+
+// CoerceInt converts value to an int64 using the Processor's configured
+// CoercionPolicy (CoercionStrict by default).
+func (p *Processor) CoerceInt(value string) (int64, error) {
+	return CoerceInt(value, p.coercion)
+}
+
+// CoerceFloat converts value to a float64 using the Processor's
+// configured CoercionPolicy (CoercionStrict by default).
+func (p *Processor) CoerceFloat(value string) (float64, error) {
+	return CoerceFloat(value, p.coercion)
+}
+
+// WithLocation sets the time.Location used by ParseDate and FormatDate,
+// in place of the default UTC. Pass time.LoadLocation("America/Sao_Paulo")
+// for banks whose date fields should be interpreted in local time rather
+// than UTC.
+func WithLocation(loc *time.Location) Option {
+	return func(p *Processor) { p.loc = loc }
+}
+
+// ParseDate parses value as a date using layout and the Processor's
+// configured location (UTC by default), applying policy to an all-zero
+// value as ParseDateInLocation does.
+func (p *Processor) ParseDate(value, layout string, policy ZeroDatePolicy) (time.Time, error) {
+	return ParseDateInLocation(value, layout, policy, p.loc)
+}
+
+// FormatDate normalizes t to the Processor's configured location before
+// formatting it with layout, as FormatDateInLocation does.
+func (p *Processor) FormatDate(t time.Time, layout string) string {
+	return FormatDateInLocation(t, layout, p.loc)
+}
+
+// WithLocale sets the locale used by LocalizeError to render a
+// *ParseError's message, overriding the package-wide default set by
+// SetLocale for operations performed through this Processor. Pass
+// "pt-BR" so validation reports shown to Brazilian back-office operators
+// read in Portuguese; ParseError.Code is unaffected.
+func WithLocale(locale string) Option {
+	return func(p *Processor) { p.locale = locale }
+}
+
+// LocalizeError renders err's message in the Processor's configured
+// locale (see WithLocale), falling back to the package-wide default set
+// by SetLocale when the Processor has none, and to err.Error() when err
+// carries no *ParseError or the catalog has no entry for its Code.
+func (p *Processor) LocalizeError(err error) string {
+	if err == nil {
+		return ""
+	}
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		return err.Error()
+	}
+	locale := p.locale
+	if locale == "" {
+		locale = currentLocale()
+	}
+	return pe.Localize(locale)
+}
+
+// WithFieldMasking enables masking of fields marked FieldSpec.Sensitive
+// in the output of MaskedJSON. It has no effect on ParseRecord,
+// PackRecord or Record.Get, which always operate on full values.
+func WithFieldMasking(enabled bool) Option {
+	return func(p *Processor) { p.mask = enabled }
+}
+
+// MaskedJSON marshals rec to JSON, replacing Sensitive fields with a
+// redaction placeholder when the Processor was constructed with
+// WithFieldMasking(true); otherwise it behaves exactly like
+// json.Marshal(rec). Use rec.Get or json.Marshal(rec) directly to bypass
+// masking and obtain full values.
+func (p *Processor) MaskedJSON(rec *Record) ([]byte, error) {
+	if !p.mask {
+		return json.Marshal(rec)
+	}
+	return json.Marshal(MaskRecord(p.spec, rec))
+}
+
+// Spec returns the RecordSpec the Processor was constructed with.
+func (p *Processor) Spec() RecordSpec {
+	return p.spec
+}
+
+// ParseRecord parses line against the Processor's spec, reporting the
+// outcome to its configured Metrics. It is equivalent to calling
+// ParseRecordContext with context.Background.
+func (p *Processor) ParseRecord(line string) (*Record, error) {
+	return p.ParseRecordContext(context.Background(), line)
+}
+
+// ParseRecordContext behaves like ParseRecord, additionally emitting a
+// span (named "cnab.ParseRecord", carrying the record type and line
+// length as attributes) via the Processor's TracerProvider.
+func (p *Processor) ParseRecordContext(ctx context.Context, line string) (*Record, error) {
+	return p.parseWithSpec(ctx, p.spec, line)
+}
+
+// LoadNamedSpec loads a RecordSpec from r via LoadSpec and registers it
+// under name for later use with ParseRecordAs/PackRecordAs, so a single
+// Processor can hold every record type in a file (header/detail/
+// trailer) and share its cross-cutting concerns (metrics, tracing,
+// logging, field handlers) across all of them, instead of file-level
+// code instantiating and wiring up one Processor per record type.
+func (p *Processor) LoadNamedSpec(ctx context.Context, name string, r io.Reader) error {
+	spec, err := LoadSpec(ctx, r)
+	if err != nil {
+		return err
+	}
+	p.specsMu.Lock()
+	defer p.specsMu.Unlock()
+	if p.specs == nil {
+		p.specs = make(map[string]RecordSpec)
+	}
+	p.specs[name] = spec
+	return nil
+}
+
+// NamedSpec returns the RecordSpec registered under name via
+// LoadNamedSpec, and whether one was found.
+func (p *Processor) NamedSpec(name string) (RecordSpec, bool) {
+	p.specsMu.RLock()
+	defer p.specsMu.RUnlock()
+	spec, ok := p.specs[name]
+	return spec, ok
+}
+
+// ParseRecordAs behaves like ParseRecordContext, but parses line against
+// the spec registered under name via LoadNamedSpec instead of the
+// Processor's primary spec.
+func (p *Processor) ParseRecordAs(ctx context.Context, name, line string) (*Record, error) {
+	p.specsMu.RLock()
+	spec, ok := p.specs[name]
+	p.specsMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("cnab: no spec registered under name %q", name)
+	}
+	return p.parseWithSpec(ctx, spec, line)
+}
+
+func (p *Processor) parseWithSpec(ctx context.Context, spec RecordSpec, line string) (*Record, error) {
+	ctx, span := p.tracer.Start(ctx, "cnab.ParseRecord")
+	span.SetAttribute("cnab.record_type", spec.Type)
+	span.SetAttribute("cnab.line_length", len(line))
+	defer span.End()
+
+	if p.encoding == encodingEBCDIC {
+		line = DecodeEBCDIC([]byte(line))
+	}
+	if p.laxTrailing {
+		if total := spec.TotalLength(); len(line) < total {
+			line += strings.Repeat(" ", total-len(line))
+		}
+	}
+
+	start := time.Now()
+	rec, err := p.parseRecord(spec, line)
+	p.metrics.Latency("parse", time.Since(start))
+	p.metrics.BytesProcessed(int64(len(line)))
+	if err != nil {
+		p.metrics.Error("parse_error")
+		p.logger.WarnContext(ctx, "cnab: parse failed", "record_type", spec.Type, "error", err)
+		return nil, err
+	}
+	p.metrics.RecordParsed()
+	p.logger.DebugContext(ctx, "cnab: record parsed", "record_type", spec.Type)
+	return rec, nil
+}
+
+// PackRecord packs values against the Processor's spec, reporting the
+// outcome to its configured Metrics. It is equivalent to calling
+// PackRecordContext with context.Background.
+func (p *Processor) PackRecord(values map[string]string) (string, error) {
+	return p.PackRecordContext(context.Background(), values)
+}
+
+// PackRecordContext behaves like PackRecord, additionally emitting a
+// span (named "cnab.PackRecord", carrying the record type as an
+// attribute) via the Processor's TracerProvider.
+func (p *Processor) PackRecordContext(ctx context.Context, values map[string]string) (string, error) {
+	return p.packWithSpec(ctx, p.spec, values)
+}
+
+// PackRecordAs behaves like PackRecordContext, but packs values against
+// the spec registered under name via LoadNamedSpec instead of the
+// Processor's primary spec.
+func (p *Processor) PackRecordAs(ctx context.Context, name string, values map[string]string) (string, error) {
+	p.specsMu.RLock()
+	spec, ok := p.specs[name]
+	p.specsMu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("cnab: no spec registered under name %q", name)
+	}
+	return p.packWithSpec(ctx, spec, values)
+}
+
+func (p *Processor) packWithSpec(ctx context.Context, spec RecordSpec, values map[string]string) (string, error) {
+	_, span := p.tracer.Start(ctx, "cnab.PackRecord")
+	span.SetAttribute("cnab.record_type", spec.Type)
+	defer span.End()
+
+	if err := p.checkOverflow(ctx, spec, values); err != nil {
+		p.metrics.Error("pack_error")
+		p.logger.WarnContext(ctx, "cnab: pack failed", "record_type", spec.Type, "error", err)
+		return "", err
+	}
+
+	start := time.Now()
+	line, err := p.packRecord(spec, values)
+	p.metrics.Latency("pack", time.Since(start))
+	if err != nil {
+		p.metrics.Error("pack_error")
+		p.logger.WarnContext(ctx, "cnab: pack failed", "record_type", spec.Type, "error", err)
+		return "", err
+	}
+	if p.encoding == encodingEBCDIC {
+		line = string(EncodeEBCDIC(line))
+	}
+	p.metrics.RecordPacked()
+	p.metrics.BytesProcessed(int64(len(line)))
+	p.logger.DebugContext(ctx, "cnab: record packed", "record_type", spec.Type)
+	return line, nil
+}
+
+// parseRecord parses line against spec, routing each field with a
+// registered FieldHandler through its Parse func instead of the normal
+// PreParse/PostFormat chain, so WithFieldHandlerStore overrides actually
+// take effect. A Processor without a FieldHandlerStore delegates straight
+// to the package-level ParseRecord, with no extra cost.
+func (p *Processor) parseRecord(spec RecordSpec, line string) (*Record, error) {
+	if p.handlers == nil {
+		return ParseRecord(spec, line)
+	}
+	rec, err := ParseRecordPreserveRaw(spec, line, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range spec.Fields {
+		h, ok := p.handlers.Lookup(f.Name, f.Type)
+		if !ok || h.Parse == nil {
+			continue
+		}
+		raw, _ := rec.Raw(f.Name)
+		v, err := h.Parse(raw)
+		if err != nil {
+			return nil, &ParseError{Field: f.Name, Msg: err.Error()}
+		}
+		rec.Set(f.Name, v)
+	}
+	return rec, nil
+}
+
+// packRecord packs values against spec, routing each field with a
+// registered FieldHandler through its Format func before PackRecordFill
+// applies padding, so WithFieldHandlerStore overrides actually take
+// effect. A Processor without a FieldHandlerStore delegates straight to
+// PackRecordFill, with no extra cost.
+func (p *Processor) packRecord(spec RecordSpec, values map[string]string) (string, error) {
+	if p.handlers == nil {
+		return PackRecordFill(spec, values, p.fill)
+	}
+	formatted := cloneValues(values)
+	for _, f := range spec.Fields {
+		h, ok := p.handlers.Lookup(f.Name, f.Type)
+		if !ok || h.Format == nil {
+			continue
+		}
+		v, ok := formatted[f.Name]
+		if !ok {
+			continue
+		}
+		out, err := h.Format(v)
+		if err != nil {
+			return "", &ParseError{Field: f.Name, Msg: err.Error()}
+		}
+		formatted[f.Name] = out
+	}
+	return PackRecordFill(spec, formatted, p.fill)
+}
+
+// checkOverflow enforces each field's OnOverflow policy (falling back to
+// WithStrictMode's blanket error when a field leaves OnOverflow unset),
+// returning an error for the first field that must reject its value.
+// OnOverflowTruncateWarn fields log a warning and let PackRecordFill
+// truncate as usual, rather than returning an error.
+func (p *Processor) checkOverflow(ctx context.Context, spec RecordSpec, values map[string]string) error {
+	for _, f := range spec.Fields {
+		v, ok := values[f.Name]
+		if !ok || len(v) <= f.Length {
+			continue
+		}
+		switch f.OnOverflow {
+		case OnOverflowTruncate:
+			continue
+		case OnOverflowTruncateWarn:
+			p.logger.WarnContext(ctx, "cnab: value truncated", "field", f.Name, "length", len(v), "max", f.Length)
+			continue
+		case OnOverflowError:
+			return &ParseError{Field: f.Name, Msg: fmt.Sprintf("value %q (%d bytes) exceeds field length %d", v, len(v), f.Length), Code: CodeFieldOverflow}
+		default:
+			if p.strict {
+				return &ParseError{Field: f.Name, Msg: fmt.Sprintf("value %q (%d bytes) exceeds field length %d", v, len(v), f.Length), Code: CodeFieldOverflow}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package cnab
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestLoadNamedSpecRegistersAndSelectsSpec(t *testing.T) {
+	headerJSON := `{"Type":"header","Fields":[{"Name":"id","Start":0,"Length":4}]}`
+	detailJSON := `{"Type":"detail","Fields":[{"Name":"amount","Start":0,"Length":6}]}`
+
+	p := NewProcessor(RecordSpec{})
+	ctx := context.Background()
+	if err := p.LoadNamedSpec(ctx, "header", strings.NewReader(headerJSON)); err != nil {
+		t.Fatalf("LoadNamedSpec(header): %v", err)
+	}
+	if err := p.LoadNamedSpec(ctx, "detail", strings.NewReader(detailJSON)); err != nil {
+		t.Fatalf("LoadNamedSpec(detail): %v", err)
+	}
+
+	if spec, ok := p.NamedSpec("header"); !ok || spec.Type != "header" {
+		t.Fatalf("NamedSpec(header) = %+v, %v", spec, ok)
+	}
+
+	rec, err := p.ParseRecordAs(ctx, "header", "0001")
+	if err != nil {
+		t.Fatalf("ParseRecordAs(header): %v", err)
+	}
+	if got, _ := rec.Get("id"); got != "0001" {
+		t.Errorf("id = %q, want 0001", got)
+	}
+
+	line, err := p.PackRecordAs(ctx, "detail", map[string]string{"amount": "42"})
+	if err != nil {
+		t.Fatalf("PackRecordAs(detail): %v", err)
+	}
+	if line != "42    " {
+		t.Errorf("PackRecordAs(detail) = %q, want %q", line, "42    ")
+	}
+}
+
+func TestParseRecordAsRejectsUnknownName(t *testing.T) {
+	p := NewProcessor(RecordSpec{})
+	if _, err := p.ParseRecordAs(context.Background(), "missing", "0001"); err == nil {
+		t.Error("expected an error for an unregistered spec name")
+	}
+}
+
+func TestNamedSpecConcurrentLoadAndUseIsRaceFree(t *testing.T) {
+	p := NewProcessor(RecordSpec{})
+	ctx := context.Background()
+	headerJSON := `{"Type":"header","Fields":[{"Name":"id","Start":0,"Length":4}]}`
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := "spec" + strconv.Itoa(i)
+			if err := p.LoadNamedSpec(ctx, name, strings.NewReader(headerJSON)); err != nil {
+				t.Errorf("LoadNamedSpec(%s): %v", name, err)
+				return
+			}
+			if _, ok := p.NamedSpec(name); !ok {
+				t.Errorf("NamedSpec(%s) not found after LoadNamedSpec", name)
+			}
+			if _, err := p.ParseRecordAs(ctx, name, "0001"); err != nil {
+				t.Errorf("ParseRecordAs(%s): %v", name, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,225 @@
+package cnab
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithSpecOverridesConstructorSpec(t *testing.T) {
+	p := NewProcessor(RecordSpec{}, WithSpec(RecordSpec{Type: "detail", Fields: []FieldSpec{{Name: "id", Start: 0, Length: 4}}}))
+	if p.Spec().Type != "detail" {
+		t.Errorf("Spec().Type = %q, want detail", p.Spec().Type)
+	}
+	if _, err := p.ParseRecord("0001"); err != nil {
+		t.Errorf("ParseRecord: %v", err)
+	}
+}
+
+func TestWithFieldHandlerStoreIsRetrievable(t *testing.T) {
+	store := NewFieldHandlerStore()
+	store.Register("amount", FieldHandler{})
+	p := NewProcessor(RecordSpec{}, WithFieldHandlerStore(store))
+	if p.handlers == nil {
+		t.Fatal("handlers not stored")
+	}
+	if _, ok := p.handlers.Lookup("amount", ""); !ok {
+		t.Error("expected amount handler to be registered")
+	}
+}
+
+func TestWithFieldHandlerStoreOverridesParseAndFormat(t *testing.T) {
+	store := NewFieldHandlerStore()
+	store.Register("flag", FieldHandler{
+		Parse: func(raw string) (string, error) {
+			if raw == "Y" {
+				return "true", nil
+			}
+			return "false", nil
+		},
+		Format: func(value string) (string, error) {
+			if value == "true" {
+				return "Y", nil
+			}
+			return "N", nil
+		},
+	})
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "flag", Start: 0, Length: 1}}}
+	p := NewProcessor(spec, WithFieldHandlerStore(store))
+
+	rec, err := p.ParseRecord("Y")
+	if err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+	if v, _ := rec.Get("flag"); v != "true" {
+		t.Errorf("flag = %q, want true (handler should have run instead of the default pass-through)", v)
+	}
+
+	line, err := p.PackRecord(map[string]string{"flag": "true"})
+	if err != nil {
+		t.Fatalf("PackRecord: %v", err)
+	}
+	if line != "Y" {
+		t.Errorf("line = %q, want Y", line)
+	}
+}
+
+func TestWithEncodingEBCDICRoundTrip(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "name", Start: 0, Length: 4}}}
+	p := NewProcessor(spec, WithEncoding("ebcdic"))
+
+	packed, err := p.PackRecord(map[string]string{"name": "BOB "})
+	if err != nil {
+		t.Fatalf("PackRecord: %v", err)
+	}
+	// The packed line is EBCDIC-encoded bytes; decode it manually to confirm.
+	if DecodeEBCDIC([]byte(packed)) != "BOB " {
+		t.Fatalf("packed line does not decode back to BOB : %q", DecodeEBCDIC([]byte(packed)))
+	}
+
+	rec, err := p.ParseRecord(packed)
+	if err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+	if v, _ := rec.Get("name"); v != "BOB " {
+		t.Errorf("name = %q, want \"BOB \"", v)
+	}
+}
+
+func TestWithStrictModeRejectsOverlongValue(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	p := NewProcessor(spec, WithStrictMode(true))
+	if _, err := p.PackRecord(map[string]string{"id": "12345"}); err == nil {
+		t.Fatal("expected strict mode to reject an overlong value")
+	}
+
+	lenient := NewProcessor(spec)
+	if _, err := lenient.PackRecord(map[string]string{"id": "12345"}); err != nil {
+		t.Errorf("expected non-strict PackRecord to truncate instead of erroring: %v", err)
+	}
+}
+
+func TestWithLaxTrailingPaddingAcceptsShortLine(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "id", Start: 0, Length: 4},
+		{Name: "note", Start: 4, Length: 6},
+	}}
+	p := NewProcessor(spec, WithLaxTrailingPadding(true))
+
+	rec, err := p.ParseRecord("0001")
+	if err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+	if v, _ := rec.Get("note"); v != "      " {
+		t.Errorf("note = %q, want spaces filled in for the missing tail", v)
+	}
+
+	strict := NewProcessor(spec)
+	if _, err := strict.ParseRecord("0001"); err == nil {
+		t.Fatal("expected the default (non-lax) processor to reject the short line")
+	}
+}
+
+func TestWithFillCharPadsWithCustomByte(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "kind", Start: 0, Length: 2, Default: "1"},
+		{Name: "name", Start: 2, Length: 4},
+	}}
+	p := NewProcessor(spec, WithFillChar('0'))
+	line, err := p.PackRecord(map[string]string{"name": "BO"})
+	if err != nil {
+		t.Fatalf("PackRecord: %v", err)
+	}
+	if line != "10BO00" {
+		t.Errorf("got %q, want %q", line, "10BO00")
+	}
+}
+
+func TestWithCoercionControlsProcessorHelpers(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	strict := NewProcessor(spec)
+	if _, err := strict.CoerceInt(" 12"); err == nil {
+		t.Error("expected the default (strict) processor to reject whitespace")
+	}
+
+	lenient := NewProcessor(spec, WithCoercion(CoercionLenient))
+	n, err := lenient.CoerceInt(" 12 ")
+	if err != nil || n != 12 {
+		t.Errorf("CoerceInt(\" 12 \") = %d, %v, want 12, nil", n, err)
+	}
+}
+
+func TestWithLocationAppliesToProcessorDateHelpers(t *testing.T) {
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skipf("America/Sao_Paulo tzdata not available: %v", err)
+	}
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	p := NewProcessor(spec, WithLocation(loc))
+
+	tm, err := p.ParseDate("20240131", "20060102", ZeroDateError)
+	if err != nil {
+		t.Fatalf("ParseDate: %v", err)
+	}
+	if tm.Location().String() != loc.String() {
+		t.Errorf("Location() = %v, want %v", tm.Location(), loc)
+	}
+
+	utcMidnight := time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC)
+	if got := p.FormatDate(utcMidnight, "20060102"); got != "20231231" {
+		t.Errorf("FormatDate = %q, want %q", got, "20231231")
+	}
+}
+
+func TestOnOverflowError(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "name", Start: 0, Length: 4, OnOverflow: OnOverflowError}}}
+	p := NewProcessor(spec)
+	if _, err := p.PackRecord(map[string]string{"name": "TOOLONG"}); err == nil {
+		t.Fatal("expected OnOverflowError to reject an overlong value")
+	}
+}
+
+func TestOnOverflowTruncateWarnLogsAndTruncates(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "name", Start: 0, Length: 4, OnOverflow: OnOverflowTruncateWarn}}}
+	p := NewProcessor(spec, WithLogger(logger))
+
+	line, err := p.PackRecord(map[string]string{"name": "TOOLONG"})
+	if err != nil {
+		t.Fatalf("PackRecord: %v", err)
+	}
+	if line != "TOOL" {
+		t.Errorf("got %q, want %q", line, "TOOL")
+	}
+	if !strings.Contains(buf.String(), "truncated") {
+		t.Errorf("expected a truncation warning to be logged, got: %s", buf.String())
+	}
+}
+
+func TestOnOverflowUnsetFallsBackToStrictMode(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "name", Start: 0, Length: 4}}}
+	strict := NewProcessor(spec, WithStrictMode(true))
+	if _, err := strict.PackRecord(map[string]string{"name": "TOOLONG"}); err == nil {
+		t.Fatal("expected WithStrictMode to still reject a field with no explicit OnOverflow")
+	}
+
+	lenient := NewProcessor(spec)
+	if _, err := lenient.PackRecord(map[string]string{"name": "TOOLONG"}); err != nil {
+		t.Errorf("expected the default non-strict processor to truncate: %v", err)
+	}
+}
+
+func TestWithLaxTrailingPaddingStillRejectsMissingRequiredField(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "id", Start: 0, Length: 4},
+		{Name: "note", Start: 4, Length: 6, Validators: []string{"required"}},
+	}}
+	p := NewProcessor(spec, WithLaxTrailingPadding(true))
+
+	if _, err := p.ParseRecord("0001"); err == nil {
+		t.Fatal("expected a required field padded to all spaces to still fail validation")
+	}
+}
@@ -0,0 +1,113 @@
+package cnab
+
+// FieldValue is a single named field value as it appears within a record,
+// in spec order.
+type FieldValue struct {
+	Name  string
+	Value string
+}
+
+// Record is an ordered collection of field values produced by ParseRecord.
+//
+// Unlike a map[string]interface{}, Record preserves the order in which
+// fields were declared in the RecordSpec, which matters for faithful
+// round-tripping, diffing and export tooling.
+type Record struct {
+	values []FieldValue
+	index  map[string]int
+	groups map[string][]*Record
+	raw    map[string]string
+}
+
+// NewRecord creates an empty Record.
+func NewRecord() *Record {
+	return &Record{index: make(map[string]int)}
+}
+
+// Group returns the parsed occurrences of the named repeating group, one
+// Record per occurrence in order, and whether the group was present.
+func (r *Record) Group(name string) ([]*Record, bool) {
+	if r == nil || r.groups == nil {
+		return nil, false
+	}
+	g, ok := r.groups[name]
+	return g, ok
+}
+
+// SetGroup assigns the occurrences of the named repeating group.
+func (r *Record) SetGroup(name string, occurrences []*Record) {
+	if r.groups == nil {
+		r.groups = make(map[string][]*Record)
+	}
+	r.groups[name] = occurrences
+}
+
+// Fields returns the field values in spec order. The returned slice must
+// not be modified by the caller.
+func (r *Record) Fields() []FieldValue {
+	if r == nil {
+		return nil
+	}
+	return r.values
+}
+
+// Get returns the value of the named field and whether it was present.
+func (r *Record) Get(name string) (string, bool) {
+	if r == nil {
+		return "", false
+	}
+	i, ok := r.index[name]
+	if !ok {
+		return "", false
+	}
+	return r.values[i].Value, true
+}
+
+// Set assigns the value of the named field, appending it in order if it is
+// not already present.
+func (r *Record) Set(name, value string) {
+	if i, ok := r.index[name]; ok {
+		r.values[i].Value = value
+		return
+	}
+	r.index[name] = len(r.values)
+	r.values = append(r.values, FieldValue{Name: name, Value: value})
+}
+
+// Reset clears the record's fields in place, retaining its backing
+// storage so it can be reused across calls to ParseRecordInto without
+// allocating.
+func (r *Record) Reset() {
+	r.values = r.values[:0]
+	for k := range r.index {
+		delete(r.index, k)
+	}
+	for k := range r.groups {
+		delete(r.groups, k)
+	}
+	for k := range r.raw {
+		delete(r.raw, k)
+	}
+}
+
+// Raw returns the original, untransformed substring a field was parsed
+// from, and whether raw preservation was requested for this record (see
+// ParseRecordPreserveRaw). Unlike Get, the value returned by Raw has not
+// had PreParse transforms or padding stripped, matching exactly what the
+// bank sent — needed by audit and reconciliation systems.
+func (r *Record) Raw(name string) (string, bool) {
+	if r == nil || r.raw == nil {
+		return "", false
+	}
+	v, ok := r.raw[name]
+	return v, ok
+}
+
+// setRaw records the original substring for name, lazily allocating the
+// backing map on first use.
+func (r *Record) setRaw(name, value string) {
+	if r.raw == nil {
+		r.raw = make(map[string]string)
+	}
+	r.raw[name] = value
+}
@@ -0,0 +1,46 @@
+package cnab
+
+import "testing"
+
+func TestParseRecordPreservesOrder(t *testing.T) {
+	spec := RecordSpec{
+		Type: "detail",
+		Fields: []FieldSpec{
+			{Name: "agency", Start: 0, Length: 4},
+			{Name: "account", Start: 4, Length: 6},
+			{Name: "name", Start: 10, Length: 5},
+		},
+	}
+
+	rec, err := ParseRecord(spec, "0001123456ALICE")
+	if err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+
+	fields := rec.Fields()
+	if len(fields) != 3 {
+		t.Fatalf("expected 3 fields, got %d", len(fields))
+	}
+
+	want := []FieldValue{
+		{Name: "agency", Value: "0001"},
+		{Name: "account", Value: "123456"},
+		{Name: "name", Value: "ALICE"},
+	}
+	for i, f := range want {
+		if fields[i] != f {
+			t.Errorf("fields[%d] = %+v, want %+v", i, fields[i], f)
+		}
+	}
+
+	if v, ok := rec.Get("account"); !ok || v != "123456" {
+		t.Errorf("Get(account) = %q, %v", v, ok)
+	}
+}
+
+func TestParseRecordLineTooShort(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "agency", Start: 0, Length: 10}}}
+	if _, err := ParseRecord(spec, "123"); err == nil {
+		t.Fatal("expected error for short line")
+	}
+}
@@ -0,0 +1,68 @@
+package cnab
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Registry indexes FileSpecs by bank code and layout version, so callers
+// can look up the right layout for a file without hard-coding spec
+// selection logic.
+type Registry struct {
+	mu    sync.RWMutex
+	specs map[string]map[string]FileSpec
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{specs: make(map[string]map[string]FileSpec)}
+}
+
+// Register adds spec under (bank, version), overwriting any previous
+// entry for the same pair.
+func (r *Registry) Register(bank, version string, spec FileSpec) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.specs[bank] == nil {
+		r.specs[bank] = make(map[string]FileSpec)
+	}
+	r.specs[bank][version] = spec
+}
+
+// Lookup returns the FileSpec registered for (bank, version).
+func (r *Registry) Lookup(bank, version string) (FileSpec, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	versions, ok := r.specs[bank]
+	if !ok {
+		return FileSpec{}, false
+	}
+	spec, ok := versions[version]
+	return spec, ok
+}
+
+// MustLookup is like Lookup but panics if no spec is registered for
+// (bank, version). It is intended for program startup, where a missing
+// spec is a configuration error rather than a runtime condition.
+func (r *Registry) MustLookup(bank, version string) FileSpec {
+	spec, ok := r.Lookup(bank, version)
+	if !ok {
+		panic(fmt.Sprintf("cnab: no spec registered for bank %q version %q", bank, version))
+	}
+	return spec
+}
+
+// DefaultRegistry is the package-level Registry used by Register and
+// Lookup for callers that don't need an isolated registry of their own.
+var DefaultRegistry = NewRegistry()
+
+// Register adds spec to DefaultRegistry under (bank, version).
+func Register(bank, version string, spec FileSpec) {
+	DefaultRegistry.Register(bank, version, spec)
+}
+
+// Lookup returns the FileSpec registered with DefaultRegistry for
+// (bank, version).
+func Lookup(bank, version string) (FileSpec, bool) {
+	return DefaultRegistry.Lookup(bank, version)
+}
@@ -0,0 +1,53 @@
+package cnab
+
+import (
+	"log/slog"
+	"time"
+)
+
+// Reload calls loader and, on success, atomically replaces the spec
+// registered for (bank, version). Readers calling Lookup concurrently
+// either see the old spec or the new one, never a partial update.
+func (r *Registry) Reload(bank, version string, loader func() (FileSpec, error)) error {
+	spec, err := loader()
+	if err != nil {
+		return err
+	}
+	r.Register(bank, version, spec)
+	return nil
+}
+
+// ReloadLogged behaves like Reload, additionally logging the outcome
+// (info on success, warn on failure) through logger, so long-running
+// processes can surface spec reloads without the caller wiring that up
+// by hand every time.
+func (r *Registry) ReloadLogged(bank, version string, loader func() (FileSpec, error), logger *slog.Logger) error {
+	err := r.Reload(bank, version, loader)
+	if err != nil {
+		logger.Warn("cnab: spec reload failed", "bank", bank, "version", version, "error", err)
+		return err
+	}
+	logger.Info("cnab: spec reloaded", "bank", bank, "version", version)
+	return nil
+}
+
+// Watch calls Reload for (bank, version) every interval until stop is
+// closed, logging reload failures via onError (which may be nil to
+// ignore them). It runs in the calling goroutine's background via an
+// internal goroutine and returns immediately.
+func (r *Registry) Watch(bank, version string, interval time.Duration, loader func() (FileSpec, error), stop <-chan struct{}, onError func(error)) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if err := r.Reload(bank, version, loader); err != nil && onError != nil {
+					onError(err)
+				}
+			}
+		}
+	}()
+}
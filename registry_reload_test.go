@@ -0,0 +1,41 @@
+package cnab
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRegistryReload(t *testing.T) {
+	r := NewRegistry()
+	r.Register("001", "v1", FileSpec{Detail: RecordSpec{Type: "old"}})
+
+	err := r.Reload("001", "v1", func() (FileSpec, error) {
+		return FileSpec{Detail: RecordSpec{Type: "new"}}, nil
+	})
+	if err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	spec, _ := r.Lookup("001", "v1")
+	if spec.Detail.Type != "new" {
+		t.Errorf("got %q, want new", spec.Detail.Type)
+	}
+}
+
+func TestRegistryWatch(t *testing.T) {
+	r := NewRegistry()
+	var calls int32
+	stop := make(chan struct{})
+	r.Watch("001", "v1", 5*time.Millisecond, func() (FileSpec, error) {
+		atomic.AddInt32(&calls, 1)
+		return FileSpec{}, nil
+	}, stop, nil)
+
+	time.Sleep(30 * time.Millisecond)
+	close(stop)
+	time.Sleep(10 * time.Millisecond)
+
+	if atomic.LoadInt32(&calls) == 0 {
+		t.Error("expected at least one reload call")
+	}
+}
@@ -0,0 +1,27 @@
+package cnab
+
+import "testing"
+
+func TestRegistryLookup(t *testing.T) {
+	r := NewRegistry()
+	spec := FileSpec{Detail: RecordSpec{Type: "detail"}}
+	r.Register("001", "cnab400", spec)
+
+	got, ok := r.Lookup("001", "cnab400")
+	if !ok || got.Detail.Type != "detail" {
+		t.Fatalf("Lookup = %+v, %v", got, ok)
+	}
+	if _, ok := r.Lookup("001", "cnab240"); ok {
+		t.Error("expected no entry for unregistered version")
+	}
+}
+
+func TestRegistryMustLookupPanics(t *testing.T) {
+	r := NewRegistry()
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for missing spec")
+		}
+	}()
+	r.MustLookup("999", "vX")
+}
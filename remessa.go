@@ -0,0 +1,89 @@
+package cnab
+
+import "strconv"
+
+// RemessaBuilder provides a fluent, high-level API for assembling a
+// remessa (outbound) CNAB file: set the header once, add details as they
+// become available, then Build to get the packed lines with trailer
+// totals and sequence numbers computed automatically from spec.
+type RemessaBuilder struct {
+	spec    FileSpec
+	header  map[string]string
+	details []map[string]string
+	err     error
+}
+
+// NewRemessaBuilder creates a RemessaBuilder for spec.
+func NewRemessaBuilder(spec FileSpec) *RemessaBuilder {
+	return &RemessaBuilder{spec: spec}
+}
+
+// Header sets the header record's field values.
+func (b *RemessaBuilder) Header(values map[string]string) *RemessaBuilder {
+	b.header = values
+	return b
+}
+
+// AddDetail appends one detail record's field values.
+func (b *RemessaBuilder) AddDetail(values map[string]string) *RemessaBuilder {
+	b.details = append(b.details, values)
+	return b
+}
+
+// Build packs the header, every detail and a trailer into file lines.
+// If spec.SeqField is set, it is auto-assigned per detail starting at 1.
+// If spec.TrailerCountField/TrailerSumField are set, they are computed
+// from the actual details rather than requiring the caller to track them.
+func (b *RemessaBuilder) Build() ([]string, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	lines := make([]string, 0, len(b.details)+2)
+	headerLine, err := PackRecord(b.spec.Header, b.header)
+	if err != nil {
+		return nil, err
+	}
+	lines = append(lines, headerLine)
+
+	sum := int64(0)
+	for i, values := range b.details {
+		values = cloneValues(values)
+		if b.spec.SeqField != "" {
+			values[b.spec.SeqField] = strconv.Itoa(i + 1)
+		}
+		if b.spec.AmountField != "" {
+			if n, err := strconv.ParseInt(values[b.spec.AmountField], 10, 64); err == nil {
+				sum += n
+			}
+		}
+		line, err := PackRecord(b.spec.Detail, values)
+		if err != nil {
+			return nil, &ParseError{Field: b.spec.Detail.Type, Msg: err.Error(), Line: i + 2}
+		}
+		lines = append(lines, line)
+	}
+
+	trailer := cloneValues(nil)
+	if b.spec.TrailerCountField != "" {
+		trailer[b.spec.TrailerCountField] = strconv.Itoa(len(b.details))
+	}
+	if b.spec.TrailerSumField != "" {
+		trailer[b.spec.TrailerSumField] = strconv.FormatInt(sum, 10)
+	}
+	trailerLine, err := PackRecord(b.spec.Trailer, trailer)
+	if err != nil {
+		return nil, err
+	}
+	lines = append(lines, trailerLine)
+
+	return lines, nil
+}
+
+func cloneValues(values map[string]string) map[string]string {
+	clone := make(map[string]string, len(values))
+	for k, v := range values {
+		clone[k] = v
+	}
+	return clone
+}
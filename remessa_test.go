@@ -0,0 +1,40 @@
+package cnab
+
+import "testing"
+
+func TestRemessaBuilder(t *testing.T) {
+	spec := FileSpec{
+		Header: RecordSpec{Type: "header", Fields: []FieldSpec{{Name: "type", Start: 0, Length: 1, Default: "H"}}},
+		Trailer: RecordSpec{Type: "trailer", Fields: []FieldSpec{
+			{Name: "count", Start: 0, Length: 3, PostFormat: []string{"zerofill:3"}},
+			{Name: "sum", Start: 3, Length: 6, PostFormat: []string{"zerofill:6"}},
+		}},
+		Detail: RecordSpec{Type: "detail", Fields: []FieldSpec{
+			{Name: "seq", Start: 0, Length: 2, PostFormat: []string{"zerofill:2"}},
+			{Name: "amount", Start: 2, Length: 4, PostFormat: []string{"zerofill:4"}},
+		}},
+		SeqField:          "seq",
+		AmountField:       "amount",
+		TrailerCountField: "count",
+		TrailerSumField:   "sum",
+	}
+
+	lines, err := NewRemessaBuilder(spec).
+		Header(map[string]string{}).
+		AddDetail(map[string]string{"amount": "100"}).
+		AddDetail(map[string]string{"amount": "250"}).
+		Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+
+	want := []string{"H", "010100", "020250", "002000350"}
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines, want %d: %v", len(lines), len(want), lines)
+	}
+	for i, line := range lines {
+		if line != want[i] {
+			t.Errorf("line %d = %q, want %q", i, line, want[i])
+		}
+	}
+}
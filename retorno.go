@@ -0,0 +1,81 @@
+package cnab
+
+import "fmt"
+
+// RetornoEventType classifies the records a RetornoReader emits while
+// walking a retorno (inbound) CNAB file.
+type RetornoEventType int
+
+const (
+	RetornoHeader RetornoEventType = iota
+	RetornoDetail
+	RetornoTrailer
+)
+
+// RetornoEvent is one record emitted while reading a retorno file,
+// carrying the parsed Record plus enough context (occurrence
+// description, line number) to act on it without re-parsing.
+type RetornoEvent struct {
+	Type        RetornoEventType
+	Line        int
+	Record      *Record
+	Occurrence  string // raw occurrence code, only set for RetornoDetail
+	Description string // human-readable description of Occurrence, if known
+}
+
+// RetornoReader walks a retorno file's lines and emits a typed event per
+// record, resolving occurrence codes against an OccurrenceTable so
+// callers don't need to look them up themselves.
+type RetornoReader struct {
+	spec        FileSpec
+	occField    string
+	occurrences OccurrenceTable
+}
+
+// NewRetornoReader creates a RetornoReader for spec. occurrenceField
+// names the Detail field holding the occurrence code; occurrences
+// resolves it to a description (pass StandardOccurrences for the common
+// codes, or nil to skip description lookup).
+func NewRetornoReader(spec FileSpec, occurrenceField string, occurrences OccurrenceTable) *RetornoReader {
+	return &RetornoReader{spec: spec, occField: occurrenceField, occurrences: occurrences}
+}
+
+// Read parses lines and returns one RetornoEvent per record, in file
+// order.
+func (r *RetornoReader) Read(lines []string) ([]RetornoEvent, error) {
+	if len(lines) < 2 {
+		return nil, fmt.Errorf("cnab: retorno file must contain at least a header and a trailer")
+	}
+
+	events := make([]RetornoEvent, 0, len(lines))
+
+	header, err := ParseRecordAt(r.spec.Header, lines[0], 1)
+	if err != nil {
+		return nil, err
+	}
+	events = append(events, RetornoEvent{Type: RetornoHeader, Line: 1, Record: header})
+
+	for i, line := range lines[1 : len(lines)-1] {
+		lineNo := i + 2
+		rec, err := ParseRecordAt(r.spec.Detail, line, lineNo)
+		if err != nil {
+			return nil, err
+		}
+		ev := RetornoEvent{Type: RetornoDetail, Line: lineNo, Record: rec}
+		if r.occField != "" {
+			ev.Occurrence, _ = rec.Get(r.occField)
+			if r.occurrences != nil {
+				ev.Description, _ = r.occurrences.Describe(ev.Occurrence)
+			}
+		}
+		events = append(events, ev)
+	}
+
+	trailer, err := ParseRecordAt(r.spec.Trailer, lines[len(lines)-1], len(lines))
+	if err != nil {
+		return nil, err
+	}
+	events = append(events, RetornoEvent{Type: RetornoTrailer, Line: len(lines), Record: trailer})
+
+	return events, nil
+}
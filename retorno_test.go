@@ -0,0 +1,31 @@
+package cnab
+
+import "testing"
+
+func TestRetornoReader(t *testing.T) {
+	spec := FileSpec{
+		Header:  RecordSpec{Type: "header", Fields: []FieldSpec{{Name: "type", Start: 0, Length: 1}}},
+		Trailer: RecordSpec{Type: "trailer", Fields: []FieldSpec{{Name: "type", Start: 0, Length: 1}}},
+		Detail: RecordSpec{Type: "detail", Fields: []FieldSpec{
+			{Name: "occ", Start: 0, Length: 2},
+		}},
+	}
+	reader := NewRetornoReader(spec, "occ", StandardOccurrences)
+
+	events, err := reader.Read([]string{"H", "06", "03", "T"})
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if len(events) != 4 {
+		t.Fatalf("got %d events, want 4", len(events))
+	}
+	if events[0].Type != RetornoHeader {
+		t.Errorf("events[0].Type = %v, want RetornoHeader", events[0].Type)
+	}
+	if events[1].Description != "Liquidação normal" {
+		t.Errorf("events[1].Description = %q", events[1].Description)
+	}
+	if events[3].Type != RetornoTrailer {
+		t.Errorf("events[3].Type = %v, want RetornoTrailer", events[3].Type)
+	}
+}
@@ -0,0 +1,62 @@
+package cnab
+
+import (
+	"context"
+	"fmt"
+)
+
+// Rewrite returns a copy of record with only the named fields in
+// overrides modified in place; every byte outside those fields' ranges
+// is left untouched. This is cheaper and safer than re-packing the whole
+// record from a map when only a few fields of an existing remessa line
+// need correcting.
+//
+// Override values are converted to their field's textual representation
+// via fmt.Sprint, then run through the field's PostFormat transforms and
+// Validators exactly as PackRecord would. Rewrite returns an error if a
+// key in overrides does not name a field in the Processor's spec, or if
+// record is shorter than the spec requires.
+func (p *Processor) Rewrite(ctx context.Context, record []byte, overrides map[string]interface{}) ([]byte, error) {
+	_, span := p.tracer.Start(ctx, "cnab.Rewrite")
+	span.SetAttribute("cnab.record_type", p.spec.Type)
+	span.SetAttribute("cnab.override_count", len(overrides))
+	defer span.End()
+
+	fields := make(map[string]FieldSpec, len(p.spec.Fields))
+	for _, f := range p.spec.Fields {
+		fields[f.Name] = f
+	}
+
+	out := make([]byte, len(record))
+	copy(out, record)
+
+	for name, value := range overrides {
+		f, ok := fields[name]
+		if !ok {
+			return nil, fmt.Errorf("cnab: Rewrite: unknown field %q", name)
+		}
+		if f.End() > len(out) {
+			return nil, &ParseError{Field: f.Name, Msg: "field extends beyond end of record", Start: f.Start, End: f.End()}
+		}
+
+		v := fmt.Sprint(value)
+		if len(f.PostFormat) > 0 {
+			transformed, err := applyTransforms(f.PostFormat, v)
+			if err != nil {
+				return nil, err
+			}
+			v = transformed
+		}
+		if err := validateField(f, v); err != nil {
+			return nil, err
+		}
+		if len(v) > f.Length {
+			v = v[:f.Length]
+		}
+		copy(out[f.Start:f.End()], v)
+		for i := f.Start + len(v); i < f.End(); i++ {
+			out[i] = p.fill
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,50 @@
+package cnab
+
+import (
+	"context"
+	"testing"
+)
+
+func TestProcessorRewriteOnlyTouchesOverriddenFields(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "id", Start: 0, Length: 6},
+		{Name: "name", Start: 6, Length: 10},
+		{Name: "amount", Start: 16, Length: 6},
+	}}
+	p := NewProcessor(spec)
+	original := []byte("000001JOHN DOE  000100")
+	originalCopy := append([]byte(nil), original...)
+
+	out, err := p.Rewrite(context.Background(), original, map[string]interface{}{"amount": "000250"})
+	if err != nil {
+		t.Fatalf("Rewrite: %v", err)
+	}
+	want := "000001JOHN DOE  000250"
+	if string(out) != want {
+		t.Errorf("Rewrite = %q, want %q", out, want)
+	}
+	if string(original) != string(originalCopy) {
+		t.Errorf("Rewrite mutated the input slice: %q", original)
+	}
+}
+
+func TestProcessorRewriteUnknownField(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "id", Start: 0, Length: 6}}}
+	p := NewProcessor(spec)
+	if _, err := p.Rewrite(context.Background(), []byte("000001"), map[string]interface{}{"missing": "x"}); err == nil {
+		t.Fatal("expected error for unknown field")
+	}
+}
+
+func TestProcessorRewritePadsWithConfiguredFillChar(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "amount", Start: 0, Length: 6}}}
+	p := NewProcessor(spec, WithFillChar('0'))
+	out, err := p.Rewrite(context.Background(), []byte("000100"), map[string]interface{}{"amount": "25"})
+	if err != nil {
+		t.Fatalf("Rewrite: %v", err)
+	}
+	want := "250000"
+	if string(out) != want {
+		t.Errorf("Rewrite = %q, want %q (padded with the configured fill char, not a space)", out, want)
+	}
+}
@@ -0,0 +1,45 @@
+package cnab
+
+import (
+	"context"
+	"fmt"
+)
+
+// RouteHandler processes one record dispatched by a Router.
+type RouteHandler func(ctx context.Context, rec *Record) error
+
+// Router dispatches parsed records to a handler based on their record
+// type and segment, the pair CNAB240 files use to distinguish detail
+// record shapes within the same file (e.g. record type "3", segment
+// "P" for a boleto's mandatory segment vs "Q" for the payer segment).
+// The zero value is not usable; construct one with NewRouter.
+type Router struct {
+	routes map[routeKey]RouteHandler
+}
+
+type routeKey struct {
+	recordType string
+	segment    string
+}
+
+// NewRouter returns an empty Router.
+func NewRouter() *Router {
+	return &Router{routes: make(map[routeKey]RouteHandler)}
+}
+
+// Handle registers h to process records identified by recordType and
+// segment, replacing any handler already registered for that pair. Pass
+// "" for segment on layouts that don't use segment codes (e.g. CNAB400).
+func (rt *Router) Handle(recordType, segment string, h RouteHandler) {
+	rt.routes[routeKey{recordType, segment}] = h
+}
+
+// Dispatch runs the handler registered for recordType/segment against
+// rec, returning an error if no handler was registered for that pair.
+func (rt *Router) Dispatch(ctx context.Context, recordType, segment string, rec *Record) error {
+	h, ok := rt.routes[routeKey{recordType, segment}]
+	if !ok {
+		return fmt.Errorf("cnab: no handler registered for record type %q, segment %q", recordType, segment)
+	}
+	return h(ctx, rec)
+}
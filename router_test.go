@@ -0,0 +1,32 @@
+package cnab
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRouterDispatchesToRegisteredHandler(t *testing.T) {
+	router := NewRouter()
+	var gotSegment string
+	router.Handle("3", "P", func(ctx context.Context, rec *Record) error {
+		v, _ := rec.Get("doc")
+		gotSegment = v
+		return nil
+	})
+
+	rec := NewRecord()
+	rec.Set("doc", "12345")
+	if err := router.Dispatch(context.Background(), "3", "P", rec); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+	if gotSegment != "12345" {
+		t.Errorf("handler did not receive the record, got %q", gotSegment)
+	}
+}
+
+func TestRouterDispatchUnregisteredPairErrors(t *testing.T) {
+	router := NewRouter()
+	if err := router.Dispatch(context.Background(), "3", "Q", NewRecord()); err == nil {
+		t.Fatal("expected an error for an unregistered record type/segment pair")
+	}
+}
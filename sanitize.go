@@ -0,0 +1,61 @@
+package cnab
+
+import "strings"
+
+// diacriticFold maps accented Latin letters commonly found in Brazilian
+// Portuguese names and addresses to their unaccented equivalent, since
+// FEBRABAN alphanumeric fields don't allow them and Go's standard
+// library has no built-in accent stripper.
+var diacriticFold = map[rune]rune{
+	'Á': 'A', 'À': 'A', 'Â': 'A', 'Ã': 'A', 'Ä': 'A',
+	'É': 'E', 'È': 'E', 'Ê': 'E', 'Ë': 'E',
+	'Í': 'I', 'Ì': 'I', 'Î': 'I', 'Ï': 'I',
+	'Ó': 'O', 'Ò': 'O', 'Ô': 'O', 'Õ': 'O', 'Ö': 'O',
+	'Ú': 'U', 'Ù': 'U', 'Û': 'U', 'Ü': 'U',
+	'Ç': 'C', 'Ñ': 'N', 'Ý': 'Y',
+	'á': 'a', 'à': 'a', 'â': 'a', 'ã': 'a', 'ä': 'a',
+	'é': 'e', 'è': 'e', 'ê': 'e', 'ë': 'e',
+	'í': 'i', 'ì': 'i', 'î': 'i', 'ï': 'i',
+	'ó': 'o', 'ò': 'o', 'ô': 'o', 'õ': 'o', 'ö': 'o',
+	'ú': 'u', 'ù': 'u', 'û': 'u', 'ü': 'u',
+	'ç': 'c', 'ñ': 'n', 'ý': 'y',
+}
+
+// SanitizeFEBRABAN normalizes value per the FEBRABAN convention for
+// alphanumeric fields (name, address, ...): uppercase, diacritics
+// stripped ('ç' becomes 'C', 'ã' becomes 'A'), any character outside
+// [A-Z0-9 ] removed, and runs of whitespace collapsed to a single space.
+// Banks reject files containing accented characters in these fields, so
+// PackRecord's normal length truncation isn't enough on its own.
+func SanitizeFEBRABAN(value string) (string, error) {
+	var b strings.Builder
+	b.Grow(len(value))
+	lastWasSpace := false
+	for _, r := range value {
+		if folded, ok := diacriticFold[r]; ok {
+			r = folded
+		}
+		if r >= 'a' && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+			lastWasSpace = false
+		case r == ' ':
+			if !lastWasSpace {
+				b.WriteRune(' ')
+			}
+			lastWasSpace = true
+		default:
+			// dropped: outside FEBRABAN's allowed alphanumeric set
+		}
+	}
+	return strings.TrimSpace(b.String()), nil
+}
+
+func init() {
+	RegisterTransform("febraban_alnum", func(string) (Transform, error) {
+		return SanitizeFEBRABAN, nil
+	})
+}
@@ -0,0 +1,30 @@
+package cnab
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSanitizeFEBRABAN(t *testing.T) {
+	got, err := SanitizeFEBRABAN("José da Conceição  Ltda.")
+	if err != nil {
+		t.Fatalf("SanitizeFEBRABAN: %v", err)
+	}
+	if want := "JOSE DA CONCEICAO LTDA"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestSanitizeFEBRABANAsPostFormatTransform(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "name", Start: 0, Length: 20, PostFormat: []string{"febraban_alnum"}},
+	}}
+	line, err := PackRecord(spec, map[string]string{"name": "João Ção"})
+	if err != nil {
+		t.Fatalf("PackRecord: %v", err)
+	}
+	want := "JOAO CAO" + strings.Repeat(" ", 20-len("JOAO CAO"))
+	if line != want {
+		t.Errorf("got %q, want %q", line, want)
+	}
+}
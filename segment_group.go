@@ -0,0 +1,97 @@
+package cnab
+
+import "fmt"
+
+// SegmentGroupSpec configures GroupSegments: which Record field carries
+// the segment code (P, Q, R, ...) that distinguishes detail records
+// belonging to the same título, which field carries the key (NSR/nosso
+// número) that links them together, and which segment codes a complete
+// group must contain, in order.
+type SegmentGroupSpec struct {
+	SegmentField string
+	KeyField     string
+	// Required lists the segment codes every group must contain, in the
+	// order they must appear, e.g. []string{"P", "Q"} for a group where
+	// a P segment must be immediately followed by its Q segment.
+	Required []string
+	// Title, if set, assembles a Title from each complete group's lead
+	// segment (the one matching Required[0]) using this field map.
+	Title TitleFieldMap
+}
+
+// SegmentGroup is every consecutive detail segment sharing one key,
+// assembled by GroupSegments.
+type SegmentGroup struct {
+	Key      string
+	Segments map[string]*Record
+	Order    []string
+}
+
+// SegmentGroupWarning reports why a segment group failed completeness
+// validation: a required segment missing, or present out of order.
+type SegmentGroupWarning struct {
+	Key string
+	Msg string
+}
+
+func (w SegmentGroupWarning) Error() string {
+	return fmt.Sprintf("cnab: segment group %q: %s", w.Key, w.Msg)
+}
+
+// GroupSegments walks records in order, collecting consecutive records
+// that share the same KeyField value into one SegmentGroup, then checks
+// each group against spec.Required. Groups missing a required segment or
+// presenting them out of order (an orphan P with no following Q, and
+// similar) are reported as warnings rather than aborting the walk, so a
+// caller can process the complete groups and surface the rest for
+// review. For every group that satisfies spec.Required, a Title is
+// assembled from its lead segment via spec.Title.
+func GroupSegments(records []*Record, spec SegmentGroupSpec) ([]*SegmentGroup, []*Title, []SegmentGroupWarning, error) {
+	var groups []*SegmentGroup
+	var current *SegmentGroup
+
+	for _, rec := range records {
+		key, _ := rec.Get(spec.KeyField)
+		segment, _ := rec.Get(spec.SegmentField)
+
+		if current == nil || current.Key != key {
+			current = &SegmentGroup{Key: key, Segments: make(map[string]*Record)}
+			groups = append(groups, current)
+		}
+		current.Segments[segment] = rec
+		current.Order = append(current.Order, segment)
+	}
+
+	var titles []*Title
+	var warnings []SegmentGroupWarning
+	for _, g := range groups {
+		if w, ok := g.validate(spec.Required); !ok {
+			warnings = append(warnings, w)
+			continue
+		}
+		if len(spec.Required) == 0 {
+			continue
+		}
+		lead := g.Segments[spec.Required[0]]
+		title, err := NewTitleFromRecord(lead, spec.Title)
+		if err != nil {
+			return groups, titles, warnings, err
+		}
+		titles = append(titles, title)
+	}
+	return groups, titles, warnings, nil
+}
+
+// validate reports whether g's segments appear in exactly the order
+// required, with no missing or out-of-place segment.
+func (g *SegmentGroup) validate(required []string) (SegmentGroupWarning, bool) {
+	if len(g.Order) != len(required) {
+		return SegmentGroupWarning{Key: g.Key, Msg: fmt.Sprintf("want %d segments %v, got %v", len(required), required, g.Order)}, false
+	}
+	for i, code := range required {
+		if g.Order[i] != code {
+			return SegmentGroupWarning{Key: g.Key, Msg: fmt.Sprintf("expected segment %q at position %d, got %v", code, i+1, g.Order)}, false
+		}
+	}
+	return SegmentGroupWarning{}, true
+}
@@ -0,0 +1,103 @@
+package cnab
+
+import "testing"
+
+func segmentSpec() RecordSpec {
+	return RecordSpec{Fields: []FieldSpec{
+		{Name: "nsr", Start: 0, Length: 2},
+		{Name: "segmento", Start: 2, Length: 1},
+		{Name: "nome", Start: 3, Length: 4},
+		{Name: "valor", Start: 7, Length: 4},
+	}}
+}
+
+func mustSegment(t *testing.T, line string) *Record {
+	t.Helper()
+	rec, err := ParseRecordAt(segmentSpec(), line, 1)
+	if err != nil {
+		t.Fatalf("ParseRecordAt(%q): %v", line, err)
+	}
+	return rec
+}
+
+func TestGroupSegmentsEmitsTitleForCompleteGroup(t *testing.T) {
+	spec := SegmentGroupSpec{
+		SegmentField: "segmento",
+		KeyField:     "nsr",
+		Required:     []string{"P", "Q"},
+		Title:        TitleFieldMap{PayerName: "nome", Amount: "valor"},
+	}
+	records := []*Record{
+		mustSegment(t, "01PJOAO0100"),
+		mustSegment(t, "01Q            "),
+	}
+
+	groups, titles, warnings, err := GroupSegments(records, spec)
+	if err != nil {
+		t.Fatalf("GroupSegments: %v", err)
+	}
+	if len(warnings) != 0 {
+		t.Fatalf("unexpected warnings: %v", warnings)
+	}
+	if len(groups) != 1 {
+		t.Fatalf("got %d groups, want 1", len(groups))
+	}
+	if len(titles) != 1 {
+		t.Fatalf("got %d titles, want 1", len(titles))
+	}
+	if titles[0].PayerName != "JOAO" {
+		t.Errorf("PayerName = %q, want JOAO", titles[0].PayerName)
+	}
+	if titles[0].AmountCents != 100 {
+		t.Errorf("AmountCents = %d, want 100", titles[0].AmountCents)
+	}
+}
+
+func TestGroupSegmentsWarnsOnOrphanSegment(t *testing.T) {
+	spec := SegmentGroupSpec{
+		SegmentField: "segmento",
+		KeyField:     "nsr",
+		Required:     []string{"P", "Q"},
+	}
+	records := []*Record{
+		mustSegment(t, "01PJOAO0100"),
+		mustSegment(t, "02PMARI0200"),
+	}
+
+	groups, titles, warnings, err := GroupSegments(records, spec)
+	if err != nil {
+		t.Fatalf("GroupSegments: %v", err)
+	}
+	if len(groups) != 2 {
+		t.Fatalf("got %d groups, want 2", len(groups))
+	}
+	if len(titles) != 0 {
+		t.Errorf("got %d titles, want 0 for incomplete groups", len(titles))
+	}
+	if len(warnings) != 2 {
+		t.Fatalf("got %d warnings, want 2 orphan segments flagged", len(warnings))
+	}
+	if warnings[0].Key != "01" || warnings[1].Key != "02" {
+		t.Errorf("warnings = %+v, want keys 01 and 02", warnings)
+	}
+}
+
+func TestGroupSegmentsRejectsOutOfOrderSegments(t *testing.T) {
+	spec := SegmentGroupSpec{
+		SegmentField: "segmento",
+		KeyField:     "nsr",
+		Required:     []string{"P", "Q"},
+	}
+	records := []*Record{
+		mustSegment(t, "01Q            "),
+		mustSegment(t, "01PJOAO0100"),
+	}
+
+	_, _, warnings, err := GroupSegments(records, spec)
+	if err != nil {
+		t.Fatalf("GroupSegments: %v", err)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("got %d warnings, want 1", len(warnings))
+	}
+}
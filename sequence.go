@@ -0,0 +1,17 @@
+package cnab
+
+import "strconv"
+
+// AssignSequence returns a copy of values with fieldName set to a
+// sequential integer starting at start, one per element, for detail
+// records not already going through RemessaBuilder or
+// PackBatchesParallel.
+func AssignSequence(values []map[string]string, fieldName string, start int) []map[string]string {
+	out := make([]map[string]string, len(values))
+	for i, v := range values {
+		clone := cloneValues(v)
+		clone[fieldName] = strconv.Itoa(start + i)
+		out[i] = clone
+	}
+	return out
+}
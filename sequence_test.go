@@ -0,0 +1,20 @@
+package cnab
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestAssignSequence(t *testing.T) {
+	values := []map[string]string{{"name": "A"}, {"name": "B"}, {"name": "C"}}
+	out := AssignSequence(values, "seq", 1)
+	for i, v := range out {
+		want := strconv.Itoa(i + 1)
+		if v["seq"] != want {
+			t.Errorf("out[%d][seq] = %q, want %q", i, v["seq"], want)
+		}
+	}
+	if _, ok := values[0]["seq"]; ok {
+		t.Error("AssignSequence must not mutate the input maps")
+	}
+}
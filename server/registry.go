@@ -0,0 +1,51 @@
+// Package server implements the HTTP surface for cmd/cnab-server: a spec
+// registry loaded from a directory tree, and parse/pack/validate
+// endpoints selecting a spec per request by bank and version.
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/RafOSS-br/cnab-stream"
+)
+
+// LoadRegistryDir walks fsys for files named "{bank}/{version}.json",
+// each containing the JSON encoding of a cnab.FileSpec, and registers
+// them into a new Registry.
+func LoadRegistryDir(fsys fs.FS) (*cnab.Registry, error) {
+	registry := cnab.NewRegistry()
+
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(p, ".json") {
+			return nil
+		}
+
+		bank := path.Dir(p)
+		version := strings.TrimSuffix(path.Base(p), ".json")
+		if bank == "." {
+			return fmt.Errorf("server: spec file %q must be nested under a bank directory", p)
+		}
+
+		data, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+		var spec cnab.FileSpec
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return fmt.Errorf("server: decoding %s: %w", p, err)
+		}
+		registry.Register(bank, version, spec)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return registry, nil
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/RafOSS-br/cnab-stream"
+)
+
+// Server is an http.Handler exposing parse, pack, and validate endpoints
+// backed by a cnab.Registry, with per-request spec selection by bank and
+// version.
+type Server struct {
+	mux      *http.ServeMux
+	registry *cnab.Registry
+	logger   *slog.Logger
+}
+
+// New returns a Server routing requests against registry, logging
+// request-handling errors through logger.
+func New(registry *cnab.Registry, logger *slog.Logger) *Server {
+	s := &Server{mux: http.NewServeMux(), registry: registry, logger: logger}
+	s.mux.HandleFunc("/v1/parse", s.handleParse)
+	s.mux.HandleFunc("/v1/pack", s.handlePack)
+	s.mux.HandleFunc("/v1/validate", s.handleValidate)
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// recordSpecFor picks the RecordSpec named by the "record" query
+// parameter ("header", "detail" or "trailer"; default "detail") from the
+// FileSpec registered under the "bank" and "version" query parameters.
+func (s *Server) recordSpecFor(r *http.Request) (cnab.RecordSpec, bool) {
+	fileSpec, ok := s.registry.Lookup(r.URL.Query().Get("bank"), r.URL.Query().Get("version"))
+	if !ok {
+		return cnab.RecordSpec{}, false
+	}
+	switch strings.ToLower(r.URL.Query().Get("record")) {
+	case "header":
+		return fileSpec.Header, true
+	case "trailer":
+		return fileSpec.Trailer, true
+	default:
+		return fileSpec.Detail, true
+	}
+}
+
+type parseRequest struct {
+	Line string `json:"line"`
+}
+
+func (s *Server) handleParse(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	spec, ok := s.recordSpecFor(r)
+	if !ok {
+		http.Error(w, "unknown bank/version", http.StatusNotFound)
+		return
+	}
+	var req parseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	rec, err := cnab.ParseRecord(spec, req.Line)
+	if err != nil {
+		s.logger.Warn("server: parse failed", "error", err)
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	writeJSON(w, rec)
+}
+
+type packRequest struct {
+	Values map[string]string `json:"values"`
+}
+
+type packResponse struct {
+	Line string `json:"line"`
+}
+
+func (s *Server) handlePack(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	spec, ok := s.recordSpecFor(r)
+	if !ok {
+		http.Error(w, "unknown bank/version", http.StatusNotFound)
+		return
+	}
+	var req packRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	line, err := cnab.PackRecord(spec, req.Values)
+	if err != nil {
+		s.logger.Warn("server: pack failed", "error", err)
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	writeJSON(w, packResponse{Line: line})
+}
+
+type validateRequest struct {
+	Lines []string `json:"lines"`
+}
+
+func (s *Server) handleValidate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	fileSpec, ok := s.registry.Lookup(r.URL.Query().Get("bank"), r.URL.Query().Get("version"))
+	if !ok {
+		http.Error(w, "unknown bank/version", http.StatusNotFound)
+		return
+	}
+	var req validateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	report, err := cnab.NewFileProcessor(fileSpec).Validate(req.Lines)
+	if err != nil {
+		s.logger.Warn("server: validate failed", "error", err)
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	writeJSON(w, report)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
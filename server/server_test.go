@@ -0,0 +1,108 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/RafOSS-br/cnab-stream"
+)
+
+func testServer(t *testing.T) *Server {
+	t.Helper()
+	fsys := fstest.MapFS{
+		"001/v1.json": &fstest.MapFile{Data: []byte(`{
+			"Detail": {"Type": "detail", "Fields": [{"Name": "id", "Start": 0, "Length": 4}]}
+		}`)},
+	}
+	registry, err := LoadRegistryDir(fsys)
+	if err != nil {
+		t.Fatalf("LoadRegistryDir: %v", err)
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return New(registry, logger)
+}
+
+func TestHandleParse(t *testing.T) {
+	s := testServer(t)
+	body, _ := json.Marshal(parseRequest{Line: "0001"})
+	req := httptest.NewRequest("POST", "/v1/parse?bank=001&version=v1", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+
+	if w.Code != 200 {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+	var rec map[string]string
+	if err := json.Unmarshal(w.Body.Bytes(), &rec); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if rec["id"] != "0001" {
+		t.Errorf("rec = %v, want id=0001", rec)
+	}
+}
+
+func TestHandleParseUnknownSpec(t *testing.T) {
+	s := testServer(t)
+	body, _ := json.Marshal(parseRequest{Line: "0001"})
+	req := httptest.NewRequest("POST", "/v1/parse?bank=999&version=v1", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+	if w.Code != 404 {
+		t.Errorf("status = %d, want 404", w.Code)
+	}
+}
+
+func TestHandlePack(t *testing.T) {
+	s := testServer(t)
+	body, _ := json.Marshal(packRequest{Values: map[string]string{"id": "0007"}})
+	req := httptest.NewRequest("POST", "/v1/pack?bank=001&version=v1", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+
+	if w.Code != 200 {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+	var resp packResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if resp.Line != "0007" {
+		t.Errorf("Line = %q, want 0007", resp.Line)
+	}
+}
+
+func TestHandleValidate(t *testing.T) {
+	fsys := fstest.MapFS{
+		"001/v1.json": &fstest.MapFile{Data: []byte(`{
+			"Header": {"Fields": [{"Name": "h", "Start": 0, "Length": 4}]},
+			"Detail": {"Fields": [{"Name": "id", "Start": 0, "Length": 4}]},
+			"Trailer": {"Fields": [{"Name": "t", "Start": 0, "Length": 4}]}
+		}`)},
+	}
+	registry, err := LoadRegistryDir(fsys)
+	if err != nil {
+		t.Fatalf("LoadRegistryDir: %v", err)
+	}
+	s := New(registry, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	body, _ := json.Marshal(validateRequest{Lines: []string{"HEAD", "0001", "TAIL"}})
+	req := httptest.NewRequest("POST", "/v1/validate?bank=001&version=v1", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+
+	if w.Code != 200 {
+		t.Fatalf("status = %d, body = %s", w.Code, w.Body.String())
+	}
+	var report cnab.ValidationReport
+	if err := json.Unmarshal(w.Body.Bytes(), &report); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if !report.Valid() {
+		t.Errorf("expected valid report, got violations: %v", report.Violations)
+	}
+}
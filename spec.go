@@ -0,0 +1,130 @@
+package cnab
+
+// FieldSpec describes a single fixed-width field within a record layout.
+//
+// Start and Length are expressed in bytes, with Start counted from the
+// beginning of the record (position 0).
+type FieldSpec struct {
+	// Name identifies the field within the record. It must be unique
+	// within a RecordSpec.
+	Name string
+	// Start is the zero-based byte offset where the field begins. Ignored
+	// when Pos is set.
+	Start int
+	// Length is the number of bytes occupied by the field. Ignored when
+	// Pos is set.
+	Length int
+	// Pos, if set, gives Start and Length as a 1-based inclusive range
+	// ("004..007"), the convention used by many published CNAB specs.
+	// Call ResolveSpec before parsing or packing a RecordSpec that uses
+	// Pos instead of Start/Length.
+	Pos string
+	// Default is used by PackRecord when the caller does not supply a
+	// value for this field.
+	Default string
+	// PreParse lists transform rules (see RegisterTransform) applied to
+	// a field's raw value during ParseRecord, before validation and
+	// storage — e.g. "trim" to strip padding.
+	PreParse []string
+	// PostFormat lists transform rules applied to a field's value during
+	// PackRecord, before validation and padding — e.g. "upper" to
+	// normalize case on the way out.
+	PostFormat []string
+	// Validators lists validation rules applied to the field's value
+	// during ParseRecord and PackRecord, e.g. "required", "regex:^[0-9]+$",
+	// "in:01,02,03" or "max:9999". See RegisterValidator for the format of
+	// custom rules.
+	Validators []string
+	// Sensitive marks a field as containing PII (CPF, full name, ...).
+	// MaskRecord and Processor's masked output paths replace this
+	// field's value with a redaction placeholder; Record.Get and
+	// json.Marshal(record) are unaffected and keep returning the full
+	// value, per LGPD's requirement that raw values remain available to
+	// authorized callers through an explicit API.
+	Sensitive bool
+	// Type, if set to "bigint", marks the field as an arbitrary-precision
+	// integer (e.g. a 25-digit nosso número) too wide for a uint64
+	// accumulator. ParseRecord rejects a value that isn't valid base-10
+	// digits, and Record.BigInt retrieves it as a *big.Int. An empty Type
+	// is the default plain string field.
+	Type string
+	// Picture, if set, is a COBOL picture clause (e.g. "9(13)V9(2)") as
+	// published in bank layout manuals, from which ResolveSpec derives
+	// Length and Decimals — an alternative to specifying them directly
+	// that matches the manual's own notation and avoids transcription
+	// errors. Ignored once Length is non-zero.
+	Picture string
+	// Decimals is the number of implied decimal digits at the end of the
+	// field (the usual CNAB convention of storing an amount as an
+	// unscaled integer, e.g. 2 for cents). It is informational, for
+	// consumers that need to interpret the field's stored digits as a
+	// scaled amount; ParseRecord and PackRecord do not use it. Set
+	// directly, or derived from Picture by ResolveSpec.
+	Decimals int
+	// Description is a short, human-readable explanation of the field's
+	// business meaning, for documentation and schema export (see
+	// RecordSpec.ToJSONSchema) rather than parsing or validation.
+	Description string
+	// Notes carries any additional detail worth preserving alongside a
+	// field — quirks, historical context, links to the bank manual
+	// section — that doesn't belong in Description's one-line summary.
+	Notes string
+	// OnOverflow controls how PackRecordContext handles a value longer
+	// than Length: "error" rejects it, "truncate_warn" truncates it but
+	// reports the truncation via the Processor's logger, and "truncate"
+	// (or "", the default) truncates silently. It has no effect on
+	// PackRecord/PackRecordFill, which always truncate silently; use a
+	// Processor and WithStrictMode/OnOverflow for enforcement.
+	OnOverflow string
+	// Required marks a field as mandatory for documentation and schema
+	// export purposes. It does not itself enforce presence during
+	// ParseRecord or PackRecord; add a "required" rule to Validators for
+	// that.
+	Required bool
+}
+
+// End returns the exclusive end offset of the field, i.e. Start+Length.
+func (f FieldSpec) End() int {
+	return f.Start + f.Length
+}
+
+// FieldByName returns the field named name and whether it was found.
+// spec.Fields is already public and cheap to scan directly; FieldByName
+// exists for callers that only have a name in hand (e.g. a JSON payload
+// key) and want the lookup spelled out rather than re-implementing a
+// linear search.
+func (spec RecordSpec) FieldByName(name string) (FieldSpec, bool) {
+	for _, f := range spec.Fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return FieldSpec{}, false
+}
+
+// TotalLength returns the width, in bytes, of a line built from spec:
+// the highest field end offset across Fields. Groups are not currently
+// counted, matching PackRecord's own width computation.
+func (spec RecordSpec) TotalLength() int {
+	width := 0
+	for _, f := range spec.Fields {
+		if f.End() > width {
+			width = f.End()
+		}
+	}
+	return width
+}
+
+// RecordSpec describes the layout of a single record (line) type within a
+// CNAB file, as an ordered list of fields.
+type RecordSpec struct {
+	// Type is an identifier for this record layout (e.g. "header",
+	// "detail", "trailer"). It is informational and not used for parsing.
+	Type string
+	// Fields lists the fields of the record, in the order they appear on
+	// the line. Parsing and packing preserve this order.
+	Fields []FieldSpec
+	// Groups lists repeating groups ("occurs" clauses) embedded in the
+	// record. See GroupSpec.
+	Groups []GroupSpec
+}
@@ -0,0 +1,27 @@
+package cnab
+
+import "testing"
+
+func TestFieldByName(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "id", Start: 0, Length: 4},
+		{Name: "name", Start: 4, Length: 10},
+	}}
+	f, ok := spec.FieldByName("name")
+	if !ok || f.Start != 4 || f.Length != 10 {
+		t.Errorf("FieldByName(name) = %+v, %v, want the name field", f, ok)
+	}
+	if _, ok := spec.FieldByName("missing"); ok {
+		t.Error("FieldByName(missing) = true, want false")
+	}
+}
+
+func TestTotalLength(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "id", Start: 0, Length: 4},
+		{Name: "name", Start: 4, Length: 10},
+	}}
+	if got := spec.TotalLength(); got != 14 {
+		t.Errorf("TotalLength() = %d, want 14", got)
+	}
+}
@@ -0,0 +1,63 @@
+package cnab
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadSpecFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"specs/header.json": &fstest.MapFile{Data: []byte(`{
+			"Type": "header",
+			"Fields": [{"Name": "bank", "Start": 0, "Length": 3}]
+		}`)},
+	}
+	spec, err := LoadSpecFS(context.Background(), fsys, "specs/header.json")
+	if err != nil {
+		t.Fatalf("LoadSpecFS: %v", err)
+	}
+	if spec.Type != "header" || len(spec.Fields) != 1 || spec.Fields[0].Name != "bank" {
+		t.Errorf("unexpected spec: %+v", spec)
+	}
+}
+
+func TestLoadSpecFSResolvesIncludeRelativeToFile(t *testing.T) {
+	fsys := fstest.MapFS{
+		"specs/base.json": &fstest.MapFile{Data: []byte(`{
+			"Fields": [{"Name": "bank", "Start": 0, "Length": 3}]
+		}`)},
+		"specs/detail.json": &fstest.MapFile{Data: []byte(`{
+			"Include": ["base.json"],
+			"Type": "detail",
+			"Fields": [{"Name": "amount", "Start": 3, "Length": 6}]
+		}`)},
+	}
+	spec, err := LoadSpecFS(context.Background(), fsys, "specs/detail.json")
+	if err != nil {
+		t.Fatalf("LoadSpecFS: %v", err)
+	}
+	if len(spec.Fields) != 2 {
+		t.Fatalf("got %d fields, want 2 (base + detail): %+v", len(spec.Fields), spec.Fields)
+	}
+	if spec.Fields[0].Name != "bank" || spec.Fields[1].Name != "amount" {
+		t.Errorf("unexpected field order: %+v", spec.Fields)
+	}
+}
+
+func TestLoadSpecFSMissingFile(t *testing.T) {
+	fsys := fstest.MapFS{}
+	if _, err := LoadSpecFS(context.Background(), fsys, "missing.json"); err == nil {
+		t.Fatal("expected error for missing spec file")
+	}
+}
+
+func TestLoadSpecFSRejectsIncludeCycle(t *testing.T) {
+	fsys := fstest.MapFS{
+		"specs/a.json": &fstest.MapFile{Data: []byte(`{"Include": ["b.json"], "Fields": []}`)},
+		"specs/b.json": &fstest.MapFile{Data: []byte(`{"Include": ["a.json"], "Fields": []}`)},
+	}
+	if _, err := LoadSpecFS(context.Background(), fsys, "specs/a.json"); err == nil {
+		t.Fatal("expected an error for a cyclic Include chain, not a stack overflow")
+	}
+}
@@ -0,0 +1,31 @@
+package cnab
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// LoadSpec decodes a RecordSpec from r, which must contain the JSON
+// encoding of a RecordSpec (the same shape produced by encoding/json on
+// the struct itself). It is the building block used by LoadSpecFS and
+// specsource's HTTP loader. The decoded spec is checked with
+// ValidateSpec before being returned, so a malformed field definition
+// (a negative Decimals, an unresolvable width, an unknown Type) fails at
+// load time instead of surfacing as a confusing parse/pack error later.
+func LoadSpec(ctx context.Context, r io.Reader) (RecordSpec, error) {
+	tracer := defaultTracerProvider.Tracer("cnab")
+	_, span := tracer.Start(ctx, "cnab.LoadSpec")
+	defer span.End()
+
+	var spec RecordSpec
+	if err := json.NewDecoder(r).Decode(&spec); err != nil {
+		return RecordSpec{}, err
+	}
+	span.SetAttribute("spec.name", spec.Type)
+	span.SetAttribute("spec.field_count", len(spec.Fields))
+	if err := ValidateSpec(spec); err != nil {
+		return RecordSpec{}, err
+	}
+	return spec, nil
+}
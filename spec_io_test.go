@@ -0,0 +1,31 @@
+package cnab
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestLoadSpec(t *testing.T) {
+	data := `{"Type":"header","Fields":[{"Name":"id","Start":0,"Length":4}]}`
+	spec, err := LoadSpec(context.Background(), strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("LoadSpec: %v", err)
+	}
+	if spec.Type != "header" || len(spec.Fields) != 1 || spec.Fields[0].Name != "id" {
+		t.Errorf("unexpected spec: %+v", spec)
+	}
+}
+
+func TestLoadSpecRejectsInvalidFieldDefinition(t *testing.T) {
+	data := `{"Type":"header","Fields":[{"Name":"id","Start":0,"Length":4,"Decimals":-1}]}`
+	if _, err := LoadSpec(context.Background(), strings.NewReader(data)); err == nil {
+		t.Fatal("expected LoadSpec to reject a field with negative Decimals")
+	}
+}
+
+func TestLoadSpecInvalidJSON(t *testing.T) {
+	if _, err := LoadSpec(context.Background(), strings.NewReader("not json")); err == nil {
+		t.Fatal("expected error for invalid JSON")
+	}
+}
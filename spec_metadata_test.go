@@ -0,0 +1,49 @@
+package cnab
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFieldDictionaryMetadataDoesNotAffectParsing(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{
+			Name:        "cpf",
+			Start:       0,
+			Length:      11,
+			Description: "Payer's CPF, unformatted",
+			Notes:       "Some 1990s-era files zero-fill this instead of leaving it blank",
+			Required:    true,
+		},
+	}}
+	rec, err := ParseRecord(spec, "12345678901")
+	if err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+	if v, _ := rec.Get("cpf"); v != "12345678901" {
+		t.Errorf("cpf = %q, want 12345678901", v)
+	}
+}
+
+func TestToJSONSchemaIncludesDescriptionAndRequiredFlag(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "cpf", Start: 0, Length: 11, Description: "Payer's CPF", Required: true},
+	}}
+	data, err := spec.ToJSONSchema()
+	if err != nil {
+		t.Fatalf("ToJSONSchema: %v", err)
+	}
+	var schema map[string]interface{}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	props := schema["properties"].(map[string]interface{})
+	cpf := props["cpf"].(map[string]interface{})
+	if cpf["description"] != "Payer's CPF" {
+		t.Errorf("description = %v, want \"Payer's CPF\"", cpf["description"])
+	}
+	required := schema["required"].([]interface{})
+	if len(required) != 1 || required[0] != "cpf" {
+		t.Errorf("required = %v, want [cpf]", required)
+	}
+}
@@ -0,0 +1,22 @@
+package cnab
+
+import "fmt"
+
+// Slice returns the raw byte window occupied by the field named
+// fieldName within record, without parsing or validating the rest of the
+// record. It is for tooling that needs to read or rewrite a single field
+// in place — log scrubbing, masking, binary patching — without paying
+// for a full ParseRecord/PackRecord round trip.
+//
+// It returns an error if fieldName is not declared in spec, or if record
+// is shorter than the field's end offset.
+func (spec RecordSpec) Slice(record []byte, fieldName string) ([]byte, error) {
+	f, ok := spec.FieldByName(fieldName)
+	if !ok {
+		return nil, fmt.Errorf("cnab: unknown field %q", fieldName)
+	}
+	if end := f.End(); len(record) < end {
+		return nil, fmt.Errorf("cnab: field %q extends to byte %d but record is only %d bytes", fieldName, end, len(record))
+	}
+	return record[f.Start:f.End()], nil
+}
@@ -0,0 +1,38 @@
+package cnab
+
+import (
+	"bytes"
+	"testing"
+)
+
+func sliceTestSpec() RecordSpec {
+	return RecordSpec{Fields: []FieldSpec{
+		{Name: "id", Start: 0, Length: 4},
+		{Name: "amount", Start: 4, Length: 6},
+	}}
+}
+
+func TestSliceReturnsFieldWindow(t *testing.T) {
+	spec := sliceTestSpec()
+	got, err := spec.Slice([]byte("A001001234"), "amount")
+	if err != nil {
+		t.Fatalf("Slice returned error: %v", err)
+	}
+	if !bytes.Equal(got, []byte("001234")) {
+		t.Errorf("Slice = %q, want %q", got, "001234")
+	}
+}
+
+func TestSliceRejectsUnknownField(t *testing.T) {
+	spec := sliceTestSpec()
+	if _, err := spec.Slice([]byte("A001001234"), "missing"); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+}
+
+func TestSliceRejectsShortRecord(t *testing.T) {
+	spec := sliceTestSpec()
+	if _, err := spec.Slice([]byte("A001"), "amount"); err == nil {
+		t.Error("expected an error for a record shorter than the field's end offset")
+	}
+}
@@ -0,0 +1,58 @@
+package cnab
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Field1Based builds a FieldSpec from a 1-based start offset, the
+// convention used in most printed CNAB layout tables, converting it to
+// this package's internal 0-based Start.
+func Field1Based(name string, start1Based, length int) FieldSpec {
+	return FieldSpec{Name: name, Start: start1Based - 1, Length: length}
+}
+
+// ValidateSpec checks that a RecordSpec's fields are internally
+// consistent: no two fields overlap, none has a non-positive Length, a
+// negative Start or a negative Decimals, and Type/OnOverflow only use
+// recognized values. Every problem found is collected and returned
+// together via errors.Join instead of stopping at the first one, so
+// LoadSpec and LoadSpecFS (which call this automatically) report a
+// complete list in a single failure rather than one round trip per fix.
+// Fields are expected to use the 0-based, exclusive-end Start/Length
+// convention documented on FieldSpec; use Field1Based or ParsePosRange to
+// convert from 1-based layout tables before validating.
+func ValidateSpec(spec RecordSpec) error {
+	var errs []error
+	for _, f := range spec.Fields {
+		if f.Name == "" {
+			errs = append(errs, fmt.Errorf("cnab: field has empty name"))
+			continue
+		}
+		if f.Length <= 0 {
+			errs = append(errs, fmt.Errorf("cnab: field %q has non-positive length %d", f.Name, f.Length))
+		}
+		if f.Start < 0 {
+			errs = append(errs, fmt.Errorf("cnab: field %q has negative start %d", f.Name, f.Start))
+		}
+		if f.Decimals < 0 {
+			errs = append(errs, fmt.Errorf("cnab: field %q has negative Decimals %d", f.Name, f.Decimals))
+		}
+		if f.Type != "" && f.Type != fieldTypeBigInt {
+			errs = append(errs, fmt.Errorf("cnab: field %q has unknown Type %q", f.Name, f.Type))
+		}
+		switch f.OnOverflow {
+		case "", OnOverflowError, OnOverflowTruncate, OnOverflowTruncateWarn:
+		default:
+			errs = append(errs, fmt.Errorf("cnab: field %q has unknown OnOverflow %q", f.Name, f.OnOverflow))
+		}
+	}
+	for i, a := range spec.Fields {
+		for _, b := range spec.Fields[i+1:] {
+			if a.Start < b.End() && b.Start < a.End() {
+				errs = append(errs, fmt.Errorf("cnab: fields %q [%d,%d) and %q [%d,%d) overlap", a.Name, a.Start, a.End(), b.Name, b.Start, b.End()))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
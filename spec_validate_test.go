@@ -0,0 +1,50 @@
+package cnab
+
+import "testing"
+
+func TestField1Based(t *testing.T) {
+	f := Field1Based("agency", 1, 4)
+	if f.Start != 0 || f.Length != 4 {
+		t.Errorf("got start=%d length=%d, want start=0 length=4", f.Start, f.Length)
+	}
+}
+
+func TestValidateSpecOverlap(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "a", Start: 0, Length: 5},
+		{Name: "b", Start: 3, Length: 4},
+	}}
+	if err := ValidateSpec(spec); err == nil {
+		t.Fatal("expected overlap error")
+	}
+}
+
+func TestValidateSpecCollectsEveryProblem(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "amount", Start: 0, Length: 4, Decimals: -1},
+		{Name: "blank", Start: 4, Length: 0},
+		{Name: "kind", Start: 4, Length: 2, Type: "weird"},
+		{Name: "flag", Start: 6, Length: 1, OnOverflow: "explode"},
+	}}
+	err := ValidateSpec(spec)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("expected an errors.Join error, got %T", err)
+	}
+	if got := len(joined.Unwrap()); got != 4 {
+		t.Errorf("got %d joined errors, want 4: %v", got, err)
+	}
+}
+
+func TestValidateSpecOK(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "a", Start: 0, Length: 5},
+		{Name: "b", Start: 5, Length: 4},
+	}}
+	if err := ValidateSpec(spec); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,105 @@
+// Package specsource fetches a cnab.FileSpec from an HTTP(S) endpoint,
+// with TTL-based caching, ETag/If-None-Match revalidation, and fallback
+// to the last successfully fetched spec on error. It is meant to feed a
+// cnab.Registry's Reload/Watch, for platforms that centralize layout
+// definitions in a config service instead of shipping them as code.
+package specsource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/RafOSS-br/cnab-stream"
+)
+
+// Source fetches and caches a FileSpec from URL. The zero value is not
+// usable; construct one with New.
+type Source struct {
+	URL        string
+	HTTPClient *http.Client
+	TTL        time.Duration
+
+	mu        sync.Mutex
+	etag      string
+	lastGood  cnab.FileSpec
+	haveGood  bool
+	fetchedAt time.Time
+}
+
+// New returns a Source fetching url, treating a successful fetch as
+// fresh for ttl before the next Load triggers a revalidation request.
+func New(url string, ttl time.Duration) *Source {
+	return &Source{URL: url, HTTPClient: http.DefaultClient, TTL: ttl}
+}
+
+// Load returns the current FileSpec, either from cache (if still within
+// TTL), by revalidating with the server via ETag, or by fetching it
+// fresh. If the request fails or the server errors, Load falls back to
+// the last successfully fetched spec when one is available, and only
+// returns an error when no such fallback exists.
+func (s *Source) Load(ctx context.Context) (cnab.FileSpec, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.haveGood && s.TTL > 0 && time.Since(s.fetchedAt) < s.TTL {
+		return s.lastGood, nil
+	}
+
+	client := s.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	if err != nil {
+		return s.fallback(err)
+	}
+	if s.etag != "" {
+		req.Header.Set("If-None-Match", s.etag)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return s.fallback(err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		s.fetchedAt = time.Now()
+		return s.lastGood, nil
+	case http.StatusOK:
+		var spec cnab.FileSpec
+		if err := json.NewDecoder(resp.Body).Decode(&spec); err != nil {
+			return s.fallback(err)
+		}
+		s.etag = resp.Header.Get("ETag")
+		s.lastGood = spec
+		s.haveGood = true
+		s.fetchedAt = time.Now()
+		return spec, nil
+	default:
+		return s.fallback(fmt.Errorf("specsource: unexpected status %s from %s", resp.Status, s.URL))
+	}
+}
+
+// fallback returns the last known-good spec if one exists, otherwise err.
+// Must be called with s.mu held.
+func (s *Source) fallback(err error) (cnab.FileSpec, error) {
+	if s.haveGood {
+		return s.lastGood, nil
+	}
+	return cnab.FileSpec{}, err
+}
+
+// Loader adapts Load into the func() (cnab.FileSpec, error) shape
+// expected by (*cnab.Registry).Reload and Watch.
+func (s *Source) Loader() func() (cnab.FileSpec, error) {
+	return func() (cnab.FileSpec, error) {
+		return s.Load(context.Background())
+	}
+}
@@ -0,0 +1,101 @@
+package specsource
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSourceFetchesAndRevalidatesWithETag(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == "v1" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", "v1")
+		w.Write([]byte(`{"Header":{"Type":"header","Fields":[{"Name":"bank","Start":0,"Length":3}]}}`))
+	}))
+	defer srv.Close()
+
+	s := New(srv.URL, 0)
+	spec, err := s.Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if spec.Header.Type != "header" {
+		t.Fatalf("unexpected spec: %+v", spec)
+	}
+
+	spec2, err := s.Load(context.Background())
+	if err != nil {
+		t.Fatalf("second Load: %v", err)
+	}
+	if spec2.Header.Type != "header" {
+		t.Fatalf("unexpected spec on revalidation: %+v", spec2)
+	}
+	if requests != 2 {
+		t.Fatalf("got %d requests, want 2 (fetch + revalidate)", requests)
+	}
+}
+
+func TestSourceHonorsTTLWithoutRequest(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"Header":{"Type":"header"}}`))
+	}))
+	defer srv.Close()
+
+	s := New(srv.URL, time.Hour)
+	if _, err := s.Load(context.Background()); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if _, err := s.Load(context.Background()); err != nil {
+		t.Fatalf("second Load: %v", err)
+	}
+	if requests != 1 {
+		t.Fatalf("got %d requests, want 1 (second call served from cache)", requests)
+	}
+}
+
+func TestSourceFallsBackToLastGoodOnServerError(t *testing.T) {
+	fail := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"Header":{"Type":"header"}}`))
+	}))
+	defer srv.Close()
+
+	s := New(srv.URL, 0)
+	if _, err := s.Load(context.Background()); err != nil {
+		t.Fatalf("initial Load: %v", err)
+	}
+
+	fail = true
+	spec, err := s.Load(context.Background())
+	if err != nil {
+		t.Fatalf("Load should fall back instead of erroring: %v", err)
+	}
+	if spec.Header.Type != "header" {
+		t.Fatalf("fallback spec = %+v, want last known-good", spec)
+	}
+}
+
+func TestSourceErrorsWithoutFallback(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	s := New(srv.URL, 0)
+	if _, err := s.Load(context.Background()); err == nil {
+		t.Fatal("expected error when there is no last known-good spec")
+	}
+}
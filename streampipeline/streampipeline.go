@@ -0,0 +1,85 @@
+// Package streampipeline consumes raw CNAB lines from a message source,
+// parses them against a cnab.Processor, and emits the parsed records as
+// JSON to a sink, routing lines that fail to parse to a dead-letter
+// queue instead of stopping the pipeline. The Source/Sink/DeadLetterQueue
+// interfaces are transport-agnostic; see the kafkaadapter submodule for a
+// Kafka-backed implementation.
+package streampipeline
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/RafOSS-br/cnab-stream"
+)
+
+// Message is a single raw line read from a Source. It carries only the
+// line's bytes; no transport metadata (key, headers, offset) is kept.
+type Message struct {
+	Value []byte
+}
+
+// Source yields raw CNAB lines. Next returns io.EOF when the source is
+// exhausted (e.g. a bounded batch, or a topic with no more messages to
+// read within a poll deadline); a long-lived stream may simply block
+// until ctx is done instead.
+type Source interface {
+	Next(ctx context.Context) (Message, error)
+}
+
+// Sink publishes a single JSON-encoded parsed record.
+type Sink interface {
+	Publish(ctx context.Context, value []byte) error
+}
+
+// DeadLetterQueue receives lines that failed to parse, together with the
+// error that caused the rejection, for later manual correction.
+type DeadLetterQueue interface {
+	Reject(ctx context.Context, msg Message, cause error) error
+}
+
+// Stats summarizes a Run.
+type Stats struct {
+	Processed int
+	Rejected  int
+}
+
+// Run reads messages from source until it returns io.EOF or ctx is
+// done, parsing each against proc and publishing the result to sink. A
+// message that fails to parse is sent to dlq (if non-nil; otherwise it
+// is silently dropped and counted in Stats.Rejected) and processing
+// continues with the next message.
+func Run(ctx context.Context, source Source, proc *cnab.Processor, sink Sink, dlq DeadLetterQueue) (Stats, error) {
+	var stats Stats
+	for {
+		msg, err := source.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			return stats, nil
+		}
+		if err != nil {
+			return stats, err
+		}
+
+		rec, parseErr := proc.ParseRecordContext(ctx, string(msg.Value))
+		if parseErr != nil {
+			stats.Rejected++
+			if dlq != nil {
+				if err := dlq.Reject(ctx, msg, parseErr); err != nil {
+					return stats, err
+				}
+			}
+			continue
+		}
+
+		encoded, err := json.Marshal(rec)
+		if err != nil {
+			return stats, err
+		}
+		if err := sink.Publish(ctx, encoded); err != nil {
+			return stats, err
+		}
+		stats.Processed++
+	}
+}
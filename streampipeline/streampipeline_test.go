@@ -0,0 +1,79 @@
+package streampipeline
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/RafOSS-br/cnab-stream"
+)
+
+type sliceSource struct {
+	lines []string
+	i     int
+}
+
+func (s *sliceSource) Next(ctx context.Context) (Message, error) {
+	if s.i >= len(s.lines) {
+		return Message{}, io.EOF
+	}
+	line := s.lines[s.i]
+	s.i++
+	return Message{Value: []byte(line)}, nil
+}
+
+type collectingSink struct {
+	published [][]byte
+}
+
+func (s *collectingSink) Publish(ctx context.Context, value []byte) error {
+	s.published = append(s.published, value)
+	return nil
+}
+
+type collectingDLQ struct {
+	rejected []Message
+}
+
+func (d *collectingDLQ) Reject(ctx context.Context, msg Message, cause error) error {
+	d.rejected = append(d.rejected, msg)
+	return nil
+}
+
+func TestRunRoutesGoodAndBadLines(t *testing.T) {
+	spec := cnab.RecordSpec{Fields: []cnab.FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	proc := cnab.NewProcessor(spec)
+	source := &sliceSource{lines: []string{"0001", "x", "0002"}}
+	sink := &collectingSink{}
+	dlq := &collectingDLQ{}
+
+	stats, err := Run(context.Background(), source, proc, sink, dlq)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if stats.Processed != 2 || stats.Rejected != 1 {
+		t.Fatalf("stats = %+v, want Processed=2 Rejected=1", stats)
+	}
+	if len(sink.published) != 2 {
+		t.Fatalf("published %d messages, want 2", len(sink.published))
+	}
+	if len(dlq.rejected) != 1 || string(dlq.rejected[0].Value) != "x" {
+		t.Fatalf("rejected = %+v, want [\"x\"]", dlq.rejected)
+	}
+}
+
+type erroringSource struct{}
+
+func (erroringSource) Next(ctx context.Context) (Message, error) {
+	return Message{}, errors.New("boom")
+}
+
+func TestRunPropagatesSourceError(t *testing.T) {
+	spec := cnab.RecordSpec{Fields: []cnab.FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	proc := cnab.NewProcessor(spec)
+	_, err := Run(context.Background(), erroringSource{}, proc, &collectingSink{}, nil)
+	if err == nil {
+		t.Fatal("expected error to propagate from Source.Next")
+	}
+}
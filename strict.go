@@ -0,0 +1,30 @@
+package cnab
+
+import (
+	"fmt"
+	"sort"
+)
+
+// PackRecordStrict behaves like PackRecord, but first rejects values
+// containing any key not declared in spec.Fields. This catches typos in
+// caller-supplied field names (e.g. "acount" instead of "account") that
+// PackRecord would otherwise silently ignore.
+func PackRecordStrict(spec RecordSpec, values map[string]string) (string, error) {
+	known := make(map[string]bool, len(spec.Fields))
+	for _, f := range spec.Fields {
+		known[f.Name] = true
+	}
+
+	var unknown []string
+	for k := range values {
+		if !known[k] {
+			unknown = append(unknown, k)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return "", fmt.Errorf("cnab: unknown field(s) %v not declared in spec", unknown)
+	}
+
+	return PackRecord(spec, values)
+}
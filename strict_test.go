@@ -0,0 +1,20 @@
+package cnab
+
+import "testing"
+
+func TestPackRecordStrictRejectsUnknownField(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "account", Start: 0, Length: 5}}}
+	if _, err := PackRecordStrict(spec, map[string]string{"acount": "12345"}); err == nil {
+		t.Fatal("expected error for unknown field")
+	}
+	if _, err := PackRecordStrict(spec, map[string]string{"account": "12345"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateSpecRejectsEmptyName(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "", Start: 0, Length: 3}}}
+	if err := ValidateSpec(spec); err == nil {
+		t.Fatal("expected error for empty field name")
+	}
+}
@@ -0,0 +1,41 @@
+package cnab
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// SpecFromStruct builds a RecordSpec by reading `cnab:"name,pos"` tags off
+// v's fields (v must be a struct or pointer to struct), where pos is a
+// 1-based inclusive range as accepted by ParsePosRange (e.g. "001..004").
+// It is the inverse of GenerateStruct: instead of generating a struct
+// from a spec, it derives a spec from an annotated struct.
+func SpecFromStruct(v interface{}) (RecordSpec, error) {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return RecordSpec{}, fmt.Errorf("cnab: SpecFromStruct requires a struct, got %s", t.Kind())
+	}
+
+	spec := RecordSpec{Type: t.Name()}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("cnab")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		name, pos, ok := strings.Cut(tag, ",")
+		if !ok {
+			return RecordSpec{}, fmt.Errorf("cnab: field %s: tag %q must be \"name,pos\"", field.Name, tag)
+		}
+		start, length, err := ParsePosRange(pos)
+		if err != nil {
+			return RecordSpec{}, fmt.Errorf("cnab: field %s: %w", field.Name, err)
+		}
+		spec.Fields = append(spec.Fields, FieldSpec{Name: name, Start: start, Length: length})
+	}
+	return spec, nil
+}
@@ -0,0 +1,22 @@
+package cnab
+
+import "testing"
+
+type detailStruct struct {
+	Agency string `cnab:"agency,001..004"`
+	Name   string `cnab:"name,005..009"`
+	Ignore string
+}
+
+func TestSpecFromStruct(t *testing.T) {
+	spec, err := SpecFromStruct(detailStruct{})
+	if err != nil {
+		t.Fatalf("SpecFromStruct: %v", err)
+	}
+	if len(spec.Fields) != 2 {
+		t.Fatalf("got %d fields, want 2", len(spec.Fields))
+	}
+	if spec.Fields[0].Name != "agency" || spec.Fields[0].Start != 0 || spec.Fields[0].Length != 4 {
+		t.Errorf("unexpected field 0: %+v", spec.Fields[0])
+	}
+}
@@ -0,0 +1,82 @@
+package cnab
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Summary is a serializable artifact describing the outcome of processing
+// a CNAB file, suitable for job logs and dashboards.
+type Summary struct {
+	FileName      string           `json:"file_name"`
+	Bank          string           `json:"bank"`
+	LayoutVersion string           `json:"layout_version"`
+	RecordCounts  map[string]int   `json:"record_counts"`
+	TotalAmounts  map[string]int64 `json:"total_amounts,omitempty"`
+	ErrorCount    int              `json:"error_count"`
+	WarningCount  int              `json:"warning_count"`
+	Duration      time.Duration    `json:"duration"`
+	Checksum      string           `json:"checksum"`
+}
+
+// BuildSummary parses lines against spec and produces a processing
+// Summary. fileName, bank and layoutVersion are informational metadata
+// carried through to the resulting artifact.
+func BuildSummary(fileName, bank, layoutVersion string, spec FileSpec, amountField string, lines []string) (*Summary, error) {
+	start := time.Now()
+
+	s := &Summary{
+		FileName:      fileName,
+		Bank:          bank,
+		LayoutVersion: layoutVersion,
+		RecordCounts:  make(map[string]int),
+		TotalAmounts:  make(map[string]int64),
+		Checksum:      checksum(lines),
+	}
+
+	if len(lines) > 0 {
+		s.RecordCounts[spec.Header.Type]++
+		if _, err := ParseRecord(spec.Header, lines[0]); err != nil {
+			s.ErrorCount++
+		}
+	}
+	if len(lines) > 1 {
+		s.RecordCounts[spec.Trailer.Type]++
+		if _, err := ParseRecord(spec.Trailer, lines[len(lines)-1]); err != nil {
+			s.ErrorCount++
+		}
+	}
+
+	if len(lines) > 2 {
+		for _, line := range lines[1 : len(lines)-1] {
+			rec, err := ParseRecord(spec.Detail, line)
+			if err != nil {
+				s.ErrorCount++
+				continue
+			}
+			s.RecordCounts[spec.Detail.Type]++
+			if amountField != "" {
+				if v, ok := rec.Get(amountField); ok {
+					if n, err := strconv.ParseInt(strings.TrimLeft(v, "0"), 10, 64); err == nil {
+						s.TotalAmounts[spec.Detail.Type] += n
+					}
+				}
+			}
+		}
+	}
+
+	s.Duration = time.Since(start)
+	return s, nil
+}
+
+func checksum(lines []string) string {
+	h := sha256.New()
+	for _, line := range lines {
+		h.Write([]byte(line))
+		h.Write([]byte("\n"))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,31 @@
+package cnab
+
+import "testing"
+
+func TestBuildSummary(t *testing.T) {
+	spec := FileSpec{
+		Header:  RecordSpec{Type: "header", Fields: []FieldSpec{{Name: "type", Start: 0, Length: 1}}},
+		Trailer: RecordSpec{Type: "trailer", Fields: []FieldSpec{{Name: "type", Start: 0, Length: 1}}},
+		Detail: RecordSpec{Type: "detail", Fields: []FieldSpec{
+			{Name: "amount", Start: 0, Length: 6},
+		}},
+	}
+	lines := []string{"H", "000100", "000200", "T"}
+
+	s, err := BuildSummary("file.txt", "001", "v1", spec, "amount", lines)
+	if err != nil {
+		t.Fatalf("BuildSummary: %v", err)
+	}
+	if s.RecordCounts["detail"] != 2 {
+		t.Errorf("detail count = %d, want 2", s.RecordCounts["detail"])
+	}
+	if s.TotalAmounts["detail"] != 300 {
+		t.Errorf("detail total = %d, want 300", s.TotalAmounts["detail"])
+	}
+	if s.ErrorCount != 0 {
+		t.Errorf("error count = %d, want 0", s.ErrorCount)
+	}
+	if s.Checksum == "" {
+		t.Error("expected non-empty checksum")
+	}
+}
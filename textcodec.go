@@ -0,0 +1,65 @@
+package cnab
+
+import (
+	"encoding"
+	"fmt"
+	"strconv"
+)
+
+// ValuesToStrings converts values, whose entries may be strings, plain
+// numeric types, or any type implementing encoding.TextMarshaler, into
+// the map[string]string PackRecord and RecordBuilder.Set expect. This
+// lets a caller pass custom domain types (a NossoNumero, a Money) straight
+// into a pack call without first reaching for strconv or a one-off
+// String() method, and without registering a field handler.
+func ValuesToStrings(values map[string]interface{}) (map[string]string, error) {
+	out := make(map[string]string, len(values))
+	for name, v := range values {
+		s, err := valueToString(v)
+		if err != nil {
+			return nil, fmt.Errorf("cnab: field %s: %w", name, err)
+		}
+		out[name] = s
+	}
+	return out, nil
+}
+
+func valueToString(v interface{}) (string, error) {
+	switch tv := v.(type) {
+	case string:
+		return tv, nil
+	case encoding.TextMarshaler:
+		b, err := tv.MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	case fmt.Stringer:
+		return tv.String(), nil
+	case int:
+		return strconv.Itoa(tv), nil
+	case int64:
+		return strconv.FormatInt(tv, 10), nil
+	default:
+		return fmt.Sprint(tv), nil
+	}
+}
+
+// ScanValues populates dest from rec's raw string fields via
+// encoding.TextUnmarshaler, so a custom domain type (a NossoNumero, a
+// Money) can be read straight off a parsed Record without registering a
+// field handler. dest maps a field name to a pointer already holding the
+// destination value, e.g. map[string]encoding.TextUnmarshaler{"valor":
+// &money}. A name absent from rec is left untouched.
+func ScanValues(rec *Record, dest map[string]encoding.TextUnmarshaler) error {
+	for name, target := range dest {
+		v, ok := rec.Get(name)
+		if !ok {
+			continue
+		}
+		if err := target.UnmarshalText([]byte(v)); err != nil {
+			return fmt.Errorf("cnab: field %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package cnab
+
+import (
+	"encoding"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// money is a minimal encoding.TextMarshaler/TextUnmarshaler domain type,
+// stored as an unscaled integer number of cents the way CNAB amount
+// fields are, used here only to exercise ValuesToStrings/ScanValues.
+type money int64
+
+func (m money) MarshalText() ([]byte, error) {
+	return []byte(strconv.FormatInt(int64(m), 10)), nil
+}
+
+func (m *money) UnmarshalText(text []byte) error {
+	n, err := strconv.ParseInt(strings.TrimSpace(string(text)), 10, 64)
+	if err != nil {
+		return fmt.Errorf("money: %w", err)
+	}
+	*m = money(n)
+	return nil
+}
+
+func TestValuesToStringsMarshalsTextMarshaler(t *testing.T) {
+	out, err := ValuesToStrings(map[string]interface{}{
+		"valor": money(1050),
+		"nome":  "JOAO",
+		"seq":   3,
+	})
+	if err != nil {
+		t.Fatalf("ValuesToStrings: %v", err)
+	}
+	if out["valor"] != "1050" || out["nome"] != "JOAO" || out["seq"] != "3" {
+		t.Errorf("got %+v", out)
+	}
+}
+
+func TestScanValuesUnmarshalsTextUnmarshaler(t *testing.T) {
+	rec, err := ParseRecordAt(RecordSpec{Fields: []FieldSpec{
+		{Name: "valor", Start: 0, Length: 6},
+	}}, "001050", 1)
+	if err != nil {
+		t.Fatalf("ParseRecordAt: %v", err)
+	}
+
+	var m money
+	if err := ScanValues(rec, map[string]encoding.TextUnmarshaler{"valor": &m}); err != nil {
+		t.Fatalf("ScanValues: %v", err)
+	}
+	if m != 1050 {
+		t.Errorf("m = %d, want 1050", m)
+	}
+}
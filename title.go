@@ -0,0 +1,61 @@
+package cnab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Title is a bank-agnostic domain model for a título/boleto detail
+// record, assembled from a parsed Record via a caller-supplied field
+// mapping so it works across bank-specific spec field names.
+type Title struct {
+	DocumentNumber string
+	PayerDocument  string
+	PayerName      string
+	DueDate        string
+	AmountCents    int64
+}
+
+// TitleFieldMap names the Record fields that map to each Title attribute.
+// Any entry left as "" is skipped, leaving the corresponding Title field
+// at its zero value.
+type TitleFieldMap struct {
+	DocumentNumber string
+	PayerDocument  string
+	PayerName      string
+	DueDate        string
+	Amount         string
+}
+
+// NewTitleFromRecord builds a Title from rec using m to locate each
+// attribute's source field. Amount is parsed as an integer number of
+// cents (the usual CNAB convention of an implied decimal point).
+func NewTitleFromRecord(rec *Record, m TitleFieldMap) (*Title, error) {
+	t := &Title{}
+	if m.DocumentNumber != "" {
+		t.DocumentNumber, _ = rec.Get(m.DocumentNumber)
+	}
+	if m.PayerDocument != "" {
+		t.PayerDocument, _ = rec.Get(m.PayerDocument)
+	}
+	if m.PayerName != "" {
+		t.PayerName, _ = rec.Get(m.PayerName)
+	}
+	if m.DueDate != "" {
+		t.DueDate, _ = rec.Get(m.DueDate)
+	}
+	if m.Amount != "" {
+		raw, _ := rec.Get(m.Amount)
+		trimmed := strings.TrimLeft(strings.TrimSpace(raw), "0")
+		if trimmed == "" {
+			trimmed = "0"
+		}
+		n, err := strconv.ParseInt(trimmed, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cnab: title amount %q: %w", raw, err)
+		}
+		t.AmountCents = n
+	}
+	return t, nil
+}
@@ -0,0 +1,24 @@
+package cnab
+
+import "testing"
+
+func TestNewTitleFromRecord(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "doc", Start: 0, Length: 10},
+		{Name: "amount", Start: 10, Length: 8},
+	}}
+	rec, err := ParseRecord(spec, "DOC000001000012345")
+	if err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+	title, err := NewTitleFromRecord(rec, TitleFieldMap{DocumentNumber: "doc", Amount: "amount"})
+	if err != nil {
+		t.Fatalf("NewTitleFromRecord: %v", err)
+	}
+	if title.DocumentNumber != "DOC0000010" {
+		t.Errorf("DocumentNumber = %q", title.DocumentNumber)
+	}
+	if title.AmountCents != 12345 {
+		t.Errorf("AmountCents = %d, want 12345", title.AmountCents)
+	}
+}
@@ -0,0 +1,54 @@
+package cnab
+
+import "context"
+
+// Span represents a single unit of traced work, in the spirit of
+// OpenTelemetry's trace.Span but without depending on the OTel SDK from
+// this module. See the otel submodule for an adapter onto the real
+// thing.
+type Span interface {
+	// SetAttribute attaches a key/value pair to the span, e.g. spec name,
+	// record type, or line number.
+	SetAttribute(key string, value interface{})
+	// End marks the span as finished.
+	End()
+}
+
+// Tracer creates Spans for named operations.
+type Tracer interface {
+	Start(ctx context.Context, spanName string) (context.Context, Span)
+}
+
+// TracerProvider creates named Tracers, mirroring
+// go.opentelemetry.io/otel/trace.TracerProvider's shape.
+type TracerProvider interface {
+	Tracer(name string) Tracer
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(string, interface{}) {}
+func (noopSpan) End()                             {}
+
+type noopTracer struct{}
+
+func (noopTracer) Start(ctx context.Context, _ string) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+type noopTracerProvider struct{}
+
+func (noopTracerProvider) Tracer(string) Tracer { return noopTracer{} }
+
+// defaultTracerProvider is used by package-level functions (e.g.
+// LoadSpec) and by any Processor not given its own via
+// WithTracerProvider. It is a no-op until SetTracerProvider is called.
+var defaultTracerProvider TracerProvider = noopTracerProvider{}
+
+// SetTracerProvider installs tp as the default TracerProvider for
+// package-level tracing. Call it once at program startup with a real
+// OpenTelemetry-backed implementation (see the otel submodule) to enable
+// spans; the default is a no-op.
+func SetTracerProvider(tp TracerProvider) {
+	defaultTracerProvider = tp
+}
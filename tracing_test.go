@@ -0,0 +1,57 @@
+package cnab
+
+import (
+	"context"
+	"testing"
+)
+
+type recordingSpan struct {
+	name  string
+	attrs map[string]interface{}
+	ended bool
+}
+
+func (s *recordingSpan) SetAttribute(key string, value interface{}) { s.attrs[key] = value }
+func (s *recordingSpan) End()                                       { s.ended = true }
+
+type recordingTracer struct {
+	spans []*recordingSpan
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	s := &recordingSpan{name: name, attrs: map[string]interface{}{}}
+	t.spans = append(t.spans, s)
+	return ctx, s
+}
+
+type recordingTracerProvider struct {
+	tracer *recordingTracer
+}
+
+func (p recordingTracerProvider) Tracer(string) Tracer { return p.tracer }
+
+func TestProcessorEmitsSpans(t *testing.T) {
+	spec := RecordSpec{Type: "detail", Fields: []FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	tracer := &recordingTracer{}
+	p := NewProcessor(spec, WithTracerProvider(recordingTracerProvider{tracer}))
+
+	if _, err := p.ParseRecord("0001"); err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+	if _, err := p.PackRecord(map[string]string{"id": "0002"}); err != nil {
+		t.Fatalf("PackRecord: %v", err)
+	}
+
+	if len(tracer.spans) != 2 {
+		t.Fatalf("got %d spans, want 2", len(tracer.spans))
+	}
+	if tracer.spans[0].name != "cnab.ParseRecord" || !tracer.spans[0].ended {
+		t.Errorf("unexpected first span: %+v", tracer.spans[0])
+	}
+	if tracer.spans[1].name != "cnab.PackRecord" || !tracer.spans[1].ended {
+		t.Errorf("unexpected second span: %+v", tracer.spans[1])
+	}
+	if tracer.spans[0].attrs["cnab.record_type"] != "detail" {
+		t.Errorf("attrs = %v, missing record_type", tracer.spans[0].attrs)
+	}
+}
@@ -0,0 +1,40 @@
+package cnab
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SumField parses each line against spec and sums the named field as an
+// integer, for computing a trailer total independently of
+// FileProcessor/RemessaBuilder (e.g. to cross-check a trailer produced by
+// another tool).
+func SumField(spec RecordSpec, lines []string, fieldName string) (int64, error) {
+	var sum int64
+	for i, line := range lines {
+		rec, err := ParseRecordAt(spec, line, i+1)
+		if err != nil {
+			return 0, err
+		}
+		v, ok := rec.Get(fieldName)
+		if !ok {
+			continue
+		}
+		trimmed := strings.TrimLeft(strings.TrimSpace(v), "0")
+		if trimmed == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(trimmed, 10, 64)
+		if err != nil {
+			return 0, &ParseError{Field: fieldName, Msg: "not numeric: " + v, Line: i + 1}
+		}
+		sum += n
+	}
+	return sum, nil
+}
+
+// ComputeChecksum returns a stable hash of lines, suitable for detecting
+// whether a file's content changed between two processing runs.
+func ComputeChecksum(lines []string) string {
+	return checksum(lines)
+}
@@ -0,0 +1,26 @@
+package cnab
+
+import "testing"
+
+func TestSumField(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "amount", Start: 0, Length: 6}}}
+	sum, err := SumField(spec, []string{"000100", "000250"}, "amount")
+	if err != nil {
+		t.Fatalf("SumField: %v", err)
+	}
+	if sum != 350 {
+		t.Errorf("got %d, want 350", sum)
+	}
+}
+
+func TestComputeChecksumStable(t *testing.T) {
+	a := ComputeChecksum([]string{"line1", "line2"})
+	b := ComputeChecksum([]string{"line1", "line2"})
+	if a != b {
+		t.Error("expected stable checksum for identical input")
+	}
+	c := ComputeChecksum([]string{"line1", "line3"})
+	if a == c {
+		t.Error("expected different checksum for different input")
+	}
+}
@@ -0,0 +1,64 @@
+package cnab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Transform maps a field's raw value to a new value, for use as a
+// PreParse or PostFormat hook.
+type Transform func(value string) (string, error)
+
+// TransformFactory builds a Transform from the argument portion of a rule
+// string (the part after the colon, or "" if the rule has no argument).
+type TransformFactory func(arg string) (Transform, error)
+
+var transformRegistry = map[string]TransformFactory{
+	"trim": func(string) (Transform, error) {
+		return func(v string) (string, error) { return strings.TrimSpace(v), nil }, nil
+	},
+	"upper": func(string) (Transform, error) {
+		return func(v string) (string, error) { return strings.ToUpper(v), nil }, nil
+	},
+	"lower": func(string) (Transform, error) {
+		return func(v string) (string, error) { return strings.ToLower(v), nil }, nil
+	},
+	"zerofill": func(arg string) (Transform, error) {
+		width, err := strconv.Atoi(strings.TrimSpace(arg))
+		if err != nil {
+			return nil, fmt.Errorf("invalid zerofill width %q: %w", arg, err)
+		}
+		return func(v string) (string, error) {
+			if len(v) >= width {
+				return v, nil
+			}
+			return strings.Repeat("0", width-len(v)) + v, nil
+		}, nil
+	},
+}
+
+// RegisterTransform makes a custom transform rule available by name for
+// use in FieldSpec.PreParse/PostFormat, as "name" or "name:arg".
+func RegisterTransform(name string, factory TransformFactory) {
+	transformRegistry[name] = factory
+}
+
+func applyTransforms(rules []string, value string) (string, error) {
+	for _, rule := range rules {
+		name, arg, _ := strings.Cut(rule, ":")
+		factory, ok := transformRegistry[name]
+		if !ok {
+			return "", fmt.Errorf("cnab: unknown transform %q", name)
+		}
+		t, err := factory(arg)
+		if err != nil {
+			return "", fmt.Errorf("cnab: transform %q: %w", rule, err)
+		}
+		value, err = t(value)
+		if err != nil {
+			return "", fmt.Errorf("cnab: transform %q: %w", rule, err)
+		}
+	}
+	return value, nil
+}
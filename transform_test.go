@@ -0,0 +1,25 @@
+package cnab
+
+import "testing"
+
+func TestPreParseTransform(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "name", Start: 0, Length: 5, PreParse: []string{"trim", "lower"}}}}
+	rec, err := ParseRecord(spec, "BOB  ")
+	if err != nil {
+		t.Fatalf("ParseRecord: %v", err)
+	}
+	if v, _ := rec.Get("name"); v != "bob" {
+		t.Errorf("name = %q, want bob", v)
+	}
+}
+
+func TestPostFormatTransform(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "code", Start: 0, Length: 4, PostFormat: []string{"zerofill:4"}}}}
+	line, err := PackRecord(spec, map[string]string{"code": "7"})
+	if err != nil {
+		t.Fatalf("PackRecord: %v", err)
+	}
+	if line != "0007" {
+		t.Errorf("got %q, want 0007", line)
+	}
+}
@@ -0,0 +1,84 @@
+package cnab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Get reads rec's named field and parses it as T, returning a
+// descriptive error in place of the unsafe type assertions and
+// hand-rolled strconv calls callers would otherwise need. Supported T
+// are string, int64 and float64; any other type reports an error naming
+// the unsupported type rather than panicking.
+func Get[T any](rec *Record, name string) (T, error) {
+	var zero T
+	v, ok := rec.Get(name)
+	if !ok {
+		return zero, fmt.Errorf("cnab: field %q not present", name)
+	}
+	switch any(zero).(type) {
+	case string:
+		return any(v).(T), nil
+	case int64:
+		n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		if err != nil {
+			return zero, fmt.Errorf("cnab: field %q: %w", name, err)
+		}
+		return any(n).(T), nil
+	case float64:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return zero, fmt.Errorf("cnab: field %q: %w", name, err)
+		}
+		return any(f).(T), nil
+	default:
+		return zero, fmt.Errorf("cnab: Get: unsupported type %T for field %q", zero, name)
+	}
+}
+
+// TypedRecord wraps a Record with typed accessors, each returning a
+// descriptive error instead of requiring the caller to hand-roll
+// strconv/time.Parse around Record.Get.
+type TypedRecord struct {
+	rec *Record
+}
+
+// Typed wraps rec for typed field access.
+func Typed(rec *Record) TypedRecord {
+	return TypedRecord{rec: rec}
+}
+
+// String returns the named field's raw value.
+func (t TypedRecord) String(name string) (string, error) {
+	v, ok := t.rec.Get(name)
+	if !ok {
+		return "", fmt.Errorf("cnab: field %q not present", name)
+	}
+	return v, nil
+}
+
+// Int parses the named field as a base-10 integer.
+func (t TypedRecord) Int(name string) (int64, error) {
+	return Get[int64](t.rec, name)
+}
+
+// Float parses the named field as a base-10 floating point number.
+func (t TypedRecord) Float(name string) (float64, error) {
+	return Get[float64](t.rec, name)
+}
+
+// Date parses the named field using layout (a time.Parse reference
+// layout, e.g. "20060102").
+func (t TypedRecord) Date(name, layout string) (time.Time, error) {
+	v, err := t.String(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	tm, err := time.Parse(layout, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cnab: field %q: %w", name, err)
+	}
+	return tm, nil
+}
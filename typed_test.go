@@ -0,0 +1,59 @@
+package cnab
+
+import "testing"
+
+func typedTestRecord(t *testing.T) *Record {
+	t.Helper()
+	rec, err := ParseRecordAt(RecordSpec{Fields: []FieldSpec{
+		{Name: "nome", Start: 0, Length: 4},
+		{Name: "qtd", Start: 4, Length: 3},
+		{Name: "taxa", Start: 7, Length: 5},
+		{Name: "data", Start: 12, Length: 8},
+	}}, "JOAO01201.5020240131", 1)
+	if err != nil {
+		t.Fatalf("ParseRecordAt: %v", err)
+	}
+	return rec
+}
+
+func TestGetParsesSupportedTypes(t *testing.T) {
+	rec := typedTestRecord(t)
+
+	if s, err := Get[string](rec, "nome"); err != nil || s != "JOAO" {
+		t.Errorf("Get[string](nome) = %q, %v", s, err)
+	}
+	if n, err := Get[int64](rec, "qtd"); err != nil || n != 12 {
+		t.Errorf("Get[int64](qtd) = %d, %v, want 12", n, err)
+	}
+	if _, err := Get[int64](rec, "missing"); err == nil {
+		t.Error("expected an error for a missing field")
+	}
+}
+
+func TestGetRejectsUnsupportedType(t *testing.T) {
+	rec := typedTestRecord(t)
+	if _, err := Get[bool](rec, "qtd"); err == nil {
+		t.Error("expected an error for an unsupported type parameter")
+	}
+}
+
+func TestTypedRecordAccessors(t *testing.T) {
+	rec := typedTestRecord(t)
+	tr := Typed(rec)
+
+	if s, err := tr.String("nome"); err != nil || s != "JOAO" {
+		t.Errorf("String(nome) = %q, %v", s, err)
+	}
+	if n, err := tr.Int("qtd"); err != nil || n != 12 {
+		t.Errorf("Int(qtd) = %d, %v", n, err)
+	}
+	if f, err := tr.Float("taxa"); err != nil || f != 1.50 {
+		t.Errorf("Float(taxa) = %v, %v", f, err)
+	}
+	if d, err := tr.Date("data", "20060102"); err != nil || d.Year() != 2024 || d.Month() != 1 || d.Day() != 31 {
+		t.Errorf("Date(data) = %v, %v", d, err)
+	}
+	if _, err := tr.Int("nome"); err == nil {
+		t.Error("expected an error parsing a non-numeric field as int")
+	}
+}
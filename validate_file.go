@@ -0,0 +1,70 @@
+package cnab
+
+import (
+	"bufio"
+	"context"
+	"io"
+)
+
+// FieldError is one line's parse failure, as collected by ValidateFile.
+type FieldError struct {
+	Line int
+	Err  error
+}
+
+// ValidationSummary summarizes the outcome of validating a whole file
+// against a spec without materializing its parsed records, for a
+// pre-flight check before a real ingest run.
+type ValidationSummary struct {
+	TotalLines int
+	ValidLines int
+	Errors     []FieldError
+}
+
+// OK reports whether every line in the file parsed successfully.
+func (r *ValidationSummary) OK() bool {
+	return len(r.Errors) == 0
+}
+
+// ValidateFile reads r line by line and parses each against the
+// Processor's spec, without building or returning the parsed records,
+// reporting every line that failed instead of stopping at the first one.
+// It is equivalent to calling ValidateFileContext with context.Background.
+func (p *Processor) ValidateFile(r io.Reader) (*ValidationSummary, error) {
+	return p.ValidateFileContext(context.Background(), r)
+}
+
+// ValidateFileContext behaves like ValidateFile, additionally emitting a
+// span (named "cnab.ValidateFile") via the Processor's TracerProvider,
+// and stopping early with ctx.Err() if ctx is canceled between lines.
+func (p *Processor) ValidateFileContext(ctx context.Context, r io.Reader) (*ValidationSummary, error) {
+	ctx, span := p.tracer.Start(ctx, "cnab.ValidateFile")
+	span.SetAttribute("cnab.record_type", p.spec.Type)
+	defer span.End()
+
+	report := &ValidationSummary{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	lineNum := 0
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+		lineNum++
+		if _, err := ParseRecordAt(p.spec, scanner.Text(), lineNum); err != nil {
+			report.Errors = append(report.Errors, FieldError{Line: lineNum, Err: err})
+			p.metrics.Error("validate_error")
+			continue
+		}
+		report.ValidLines++
+	}
+	report.TotalLines = lineNum
+	if err := scanner.Err(); err != nil {
+		return report, err
+	}
+	span.SetAttribute("cnab.total_lines", report.TotalLines)
+	span.SetAttribute("cnab.error_count", len(report.Errors))
+	p.logger.DebugContext(ctx, "cnab: file validated", "record_type", p.spec.Type, "total_lines", report.TotalLines, "errors", len(report.Errors))
+	return report, nil
+}
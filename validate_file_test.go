@@ -0,0 +1,38 @@
+package cnab
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateFileReportsErrorsWithoutStopping(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	p := NewProcessor(spec)
+	data := strings.Join([]string{"0001", "x", "0003", "yy"}, "\n")
+
+	report, err := p.ValidateFile(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("ValidateFile: %v", err)
+	}
+	if report.TotalLines != 4 || report.ValidLines != 2 {
+		t.Fatalf("report = %+v, want TotalLines=4 ValidLines=2", report)
+	}
+	if len(report.Errors) != 2 || report.Errors[0].Line != 2 || report.Errors[1].Line != 4 {
+		t.Fatalf("Errors = %+v, want lines 2 and 4 flagged", report.Errors)
+	}
+	if report.OK() {
+		t.Error("OK() = true, want false with errors present")
+	}
+}
+
+func TestValidateFileAllValidReportsOK(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{{Name: "id", Start: 0, Length: 4}}}
+	p := NewProcessor(spec)
+	report, err := p.ValidateFile(strings.NewReader("0001\n0002\n"))
+	if err != nil {
+		t.Fatalf("ValidateFile: %v", err)
+	}
+	if !report.OK() {
+		t.Errorf("expected OK, got errors: %+v", report.Errors)
+	}
+}
@@ -0,0 +1,116 @@
+package cnab
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Validator checks a single field value, returning a non-nil error
+// describing why the value is invalid.
+type Validator func(value string) error
+
+// ValidatorFactory builds a Validator from the argument portion of a rule
+// string (the part after the colon, or "" if the rule has no argument).
+type ValidatorFactory func(arg string) (Validator, error)
+
+var validatorRegistry = map[string]ValidatorFactory{
+	"required": func(string) (Validator, error) {
+		return func(value string) error {
+			if strings.TrimSpace(value) == "" {
+				return fmt.Errorf("value is required")
+			}
+			return nil
+		}, nil
+	},
+	"regex": func(arg string) (Validator, error) {
+		re, err := regexp.Compile(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex %q: %w", arg, err)
+		}
+		return func(value string) error {
+			if !re.MatchString(value) {
+				return fmt.Errorf("value %q does not match pattern %q", value, arg)
+			}
+			return nil
+		}, nil
+	},
+	"in": func(arg string) (Validator, error) {
+		allowed := strings.Split(strings.Trim(arg, "[]"), ",")
+		for i, v := range allowed {
+			allowed[i] = strings.TrimSpace(v)
+		}
+		return func(value string) error {
+			for _, v := range allowed {
+				if value == v {
+					return nil
+				}
+			}
+			return fmt.Errorf("value %q is not one of %v", value, allowed)
+		}, nil
+	},
+	"max": func(arg string) (Validator, error) {
+		max, err := strconv.ParseFloat(strings.TrimSpace(arg), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max %q: %w", arg, err)
+		}
+		return func(value string) error {
+			n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				return fmt.Errorf("value %q is not numeric", value)
+			}
+			if n > max {
+				return fmt.Errorf("value %v exceeds max %v", n, max)
+			}
+			return nil
+		}, nil
+	},
+}
+
+// RegisterValidator makes a custom validator rule available by name for
+// use in FieldSpec.Validators, as "name" or "name:arg".
+func RegisterValidator(name string, factory ValidatorFactory) {
+	validatorRegistry[name] = factory
+}
+
+// buildValidators parses a list of rule strings into Validators.
+func buildValidators(rules []string) ([]Validator, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	validators := make([]Validator, 0, len(rules))
+	for _, rule := range rules {
+		name, arg, _ := strings.Cut(rule, ":")
+		factory, ok := validatorRegistry[name]
+		if !ok {
+			return nil, fmt.Errorf("cnab: unknown validator %q", name)
+		}
+		v, err := factory(arg)
+		if err != nil {
+			return nil, fmt.Errorf("cnab: validator %q: %w", rule, err)
+		}
+		validators = append(validators, v)
+	}
+	return validators, nil
+}
+
+// validateField runs a field's configured validators against value,
+// returning a *ParseError on the first failure.
+func validateField(f FieldSpec, value string) error {
+	if f.Type == fieldTypeBigInt {
+		if _, ok := parseBigInt(value); !ok {
+			return &ParseError{Field: f.Name, Msg: fmt.Sprintf("value %q is not a valid bigint", value), Code: CodeInvalidBigInt}
+		}
+	}
+	validators, err := buildValidators(f.Validators)
+	if err != nil {
+		return err
+	}
+	for _, v := range validators {
+		if err := v(value); err != nil {
+			return &ParseError{Field: f.Name, Msg: err.Error(), Code: CodeValidationFailed}
+		}
+	}
+	return nil
+}
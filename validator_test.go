@@ -0,0 +1,32 @@
+package cnab
+
+import "testing"
+
+func TestFieldValidators(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "kind", Start: 0, Length: 2, Validators: []string{"in:01,02"}},
+		{Name: "name", Start: 2, Length: 5, Validators: []string{"required"}},
+	}}
+
+	if _, err := ParseRecord(spec, "01ALICE"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := ParseRecord(spec, "99ALICE"); err == nil {
+		t.Fatal("expected validation error for kind")
+	}
+	if _, err := ParseRecord(spec, "01     "); err == nil {
+		t.Fatal("expected validation error for required name")
+	}
+}
+
+func TestPackRecordValidators(t *testing.T) {
+	spec := RecordSpec{Fields: []FieldSpec{
+		{Name: "amount", Start: 0, Length: 4, Validators: []string{"max:500"}},
+	}}
+	if _, err := PackRecord(spec, map[string]string{"amount": "999"}); err == nil {
+		t.Fatal("expected max validation error")
+	}
+	if _, err := PackRecord(spec, map[string]string{"amount": "100"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}